@@ -0,0 +1,47 @@
+// Command healthcheck checks the comments service by grpc.health.v1 and exits with 1 unless it
+// is serving. It is the healthcheck of the docker image, which has no shell or curl.
+//
+//	healthcheck -addr localhost:8083
+//	healthcheck -addr localhost:8083 -service example.comments.pkg.api.comments.v1.Comments
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	addr := flag.String("addr", "localhost:8083", "comments gRPC address")
+	service := flag.String("service", "", "service to check, empty for the whole server")
+	timeout := flag.Duration("timeout", 3*time.Second, "timeout of the check")
+	flag.Parse()
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fail(err)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: *service})
+	if err != nil {
+		fail(err)
+	}
+	fmt.Println(res.GetStatus())
+	if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		os.Exit(1)
+	}
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
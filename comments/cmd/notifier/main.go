@@ -0,0 +1,28 @@
+package main
+
+import (
+	"context"
+	"example/comments/internal/logger"
+	"example/comments/internal/notifier"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	logger.Infow(ctx, "Notifier starting")
+
+	app, err := notifier.NewApp(ctx, os.Getenv("CONFIG_FILE"))
+	if err != nil {
+		logger.Errorw(ctx, "notifier start failed", "error", err.Error())
+		panic(err)
+	}
+
+	if err := app.Run(ctx); err != nil {
+		logger.Errorw(ctx, "notifier failed", "error", err.Error())
+		panic(err)
+	}
+	logger.Infow(ctx, "Notifier stopped")
+}
@@ -0,0 +1,303 @@
+// Command outbox-admin lists and replays outbox notifications and generic outbox messages
+// through the CommentsAdmin gRPC service.
+//
+//	outbox-admin -addr localhost:8086 list -status send -from 2025-03-01T00:00:00Z -payload
+//	outbox-admin -addr localhost:8086 replay -from-id 100 -to-id 250 -topic notifications -dry-run
+//	outbox-admin -addr localhost:8086 messages -topic comment-events -status send -payload
+//	outbox-admin -addr localhost:8086 replay-messages -topic comment-events -from-id 10 -to-id 40 -dry-run
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	desc "example/comments/pkg/api/comments/v1"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const usage = `usage: outbox-admin [-addr host:port] <command> [flags]
+
+commands:
+  list             list outbox notifications
+  replay           enqueue outbox notifications again
+  messages         list generic outbox messages
+  replay-messages  enqueue generic outbox messages again
+`
+
+func main() {
+	addr := flag.String("addr", "localhost:8086", "comments admin gRPC address")
+	timeout := flag.Duration("timeout", 10*time.Second, "timeout of one request")
+	flag.Usage = func() {
+		fmt.Fprint(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		fail(err)
+	}
+	defer conn.Close()
+	client := desc.NewCommentsAdminClient(conn)
+
+	switch cmd, args := flag.Arg(0), flag.Args()[1:]; cmd {
+	case "list":
+		err = list(client, *timeout, args)
+	case "replay":
+		err = replay(client, *timeout, args)
+	case "messages":
+		err = listMessages(client, *timeout, args)
+	case "replay-messages":
+		err = replayMessages(client, *timeout, args)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fail(err)
+	}
+}
+
+type filterFlags struct {
+	status string
+	from   string
+	to     string
+	fromID int64
+	toID   int64
+	limit  int
+}
+
+func (f *filterFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.status, "status", "", "status: new, muted, send, delivered, read or canceled")
+	fs.StringVar(&f.from, "from", "", "created at or after, RFC3339")
+	fs.StringVar(&f.to, "to", "", "created before, RFC3339")
+	fs.Int64Var(&f.fromID, "from-id", 0, "first notification id")
+	fs.Int64Var(&f.toID, "to-id", 0, "last notification id")
+	fs.IntVar(&f.limit, "limit", 100, "notifications per request, at most 1000")
+}
+
+func (f *filterFlags) filter() (*desc.OutboxFilter, error) {
+	if f.limit < 1 || f.limit > 1000 {
+		return nil, fmt.Errorf("-limit must be in [1, 1000]")
+	}
+	filter := &desc.OutboxFilter{
+		Status: f.status,
+		FromID: f.fromID,
+		ToID:   f.toID,
+		Limit:  int32(f.limit),
+	}
+	if f.from != "" {
+		from, err := time.Parse(time.RFC3339, f.from)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -from: %w", err)
+		}
+		filter.From = timestamppb.New(from)
+	}
+	if f.to != "" {
+		to, err := time.Parse(time.RFC3339, f.to)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -to: %w", err)
+		}
+		filter.To = timestamppb.New(to)
+	}
+	return filter, nil
+}
+
+func list(client desc.CommentsAdminClient, timeout time.Duration, args []string) error {
+	fs := flag.NewFlagSet("list", flag.ExitOnError)
+	var f filterFlags
+	f.register(fs)
+	payload := fs.Bool("payload", false, "print payloads")
+	_ = fs.Parse(args)
+	filter, err := f.filter()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := client.ListOutbox(ctx, &desc.ListOutboxRequest{Filter: filter})
+	if err != nil {
+		return err
+	}
+	printNotifications(res.Notifications, *payload)
+	return nil
+}
+
+// replay repeats the request from the id after the last replayed notification until the
+// whole range is replayed.
+func replay(client desc.CommentsAdminClient, timeout time.Duration, args []string) error {
+	fs := flag.NewFlagSet("replay", flag.ExitOnError)
+	var f filterFlags
+	f.register(fs)
+	topic := fs.String("topic", "", "logical outbox topic from outbox.routes, notifications when not set")
+	dryRun := fs.Bool("dry-run", false, "only print the notifications that would be replayed")
+	payload := fs.Bool("payload", false, "print payloads")
+	_ = fs.Parse(args)
+	filter, err := f.filter()
+	if err != nil {
+		return err
+	}
+	total := 0
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		res, err := client.ReplayOutbox(ctx, &desc.ReplayOutboxRequest{
+			Filter: filter,
+			Topic:  *topic,
+			DryRun: *dryRun,
+		})
+		cancel()
+		if err != nil {
+			return err
+		}
+		printNotifications(res.Notifications, *payload)
+		total += len(res.Notifications)
+		if len(res.Notifications) < f.limit {
+			break
+		}
+		filter.FromID = res.Notifications[len(res.Notifications)-1].NotificationID + 1
+	}
+	if *dryRun {
+		fmt.Printf("%d notifications would be replayed\n", total)
+	} else {
+		fmt.Printf("%d notifications replayed\n", total)
+	}
+	return nil
+}
+
+func printNotifications(ntfs []*desc.OutboxNotification, payload bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, val := range ntfs {
+		sentAt := "-"
+		if val.SentAt != nil {
+			sentAt = val.SentAt.AsTime().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\tcomment=%d\tproduct=%d\trecipient=%d\t%s\t%s\n",
+			val.NotificationID, val.Status, val.Reason, val.CommentID, val.ProductID, val.RecipientID,
+			val.CreatedAt.AsTime().Format(time.RFC3339), sentAt)
+		if payload {
+			fmt.Fprintf(w, "\t%s\n", val.Payload)
+		}
+	}
+	_ = w.Flush()
+}
+
+type messageFilterFlags struct {
+	status string
+	topic  string
+	fromID int64
+	toID   int64
+	limit  int
+}
+
+func (f *messageFilterFlags) register(fs *flag.FlagSet) {
+	fs.StringVar(&f.status, "status", "", "status: new or send")
+	fs.StringVar(&f.topic, "topic", "", "logical outbox topic")
+	fs.Int64Var(&f.fromID, "from-id", 0, "first message id")
+	fs.Int64Var(&f.toID, "to-id", 0, "last message id")
+	fs.IntVar(&f.limit, "limit", 100, "messages per request, at most 1000")
+}
+
+func (f *messageFilterFlags) filter() (*desc.OutboxMessageFilter, error) {
+	if f.limit < 1 || f.limit > 1000 {
+		return nil, fmt.Errorf("-limit must be in [1, 1000]")
+	}
+	return &desc.OutboxMessageFilter{
+		Status: f.status,
+		Topic:  f.topic,
+		FromID: f.fromID,
+		ToID:   f.toID,
+		Limit:  int32(f.limit),
+	}, nil
+}
+
+func listMessages(client desc.CommentsAdminClient, timeout time.Duration, args []string) error {
+	fs := flag.NewFlagSet("messages", flag.ExitOnError)
+	var f messageFilterFlags
+	f.register(fs)
+	payload := fs.Bool("payload", false, "print payloads and headers")
+	_ = fs.Parse(args)
+	filter, err := f.filter()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	res, err := client.ListOutboxMessages(ctx, &desc.ListOutboxMessagesRequest{Filter: filter})
+	if err != nil {
+		return err
+	}
+	printMessages(res.Messages, *payload)
+	return nil
+}
+
+// replayMessages repeats the request from the id after the last replayed message until the
+// whole range is replayed.
+func replayMessages(client desc.CommentsAdminClient, timeout time.Duration, args []string) error {
+	fs := flag.NewFlagSet("replay-messages", flag.ExitOnError)
+	var f messageFilterFlags
+	f.register(fs)
+	dryRun := fs.Bool("dry-run", false, "only print the messages that would be replayed")
+	payload := fs.Bool("payload", false, "print payloads and headers")
+	_ = fs.Parse(args)
+	filter, err := f.filter()
+	if err != nil {
+		return err
+	}
+	total := 0
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		res, err := client.ReplayOutboxMessages(ctx, &desc.ReplayOutboxMessagesRequest{
+			Filter: filter,
+			DryRun: *dryRun,
+		})
+		cancel()
+		if err != nil {
+			return err
+		}
+		printMessages(res.Messages, *payload)
+		total += len(res.Messages)
+		if len(res.Messages) < f.limit {
+			break
+		}
+		filter.FromID = res.Messages[len(res.Messages)-1].Id + 1
+	}
+	if *dryRun {
+		fmt.Printf("%d messages would be replayed\n", total)
+	} else {
+		fmt.Printf("%d messages replayed\n", total)
+	}
+	return nil
+}
+
+func printMessages(msgs []*desc.OutboxMessage, payload bool) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, val := range msgs {
+		sentAt := "-"
+		if val.SentAt != nil {
+			sentAt = val.SentAt.AsTime().Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\tkey=%s\t%s\t%s\n",
+			val.Id, val.Status, val.Topic, val.Key, val.CreatedAt.AsTime().Format(time.RFC3339), sentAt)
+		if payload {
+			fmt.Fprintf(w, "\t%v\n", val.Headers)
+			fmt.Fprintf(w, "\t%s\n", val.Payload)
+		}
+	}
+	_ = w.Flush()
+}
+
+func fail(err error) {
+	fmt.Fprintln(os.Stderr, "outbox-admin:", err)
+	os.Exit(1)
+}
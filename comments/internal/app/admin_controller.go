@@ -0,0 +1,150 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	servicepb "example/comments/pkg/api/comments/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var _ servicepb.CommentsAdminServer = (*AdminController)(nil)
+
+type OutboxAdminService interface {
+	ListOutbox(ctx context.Context, filter model.OutboxFilter) ([]model.OutboxRecord, error)
+	ReplayOutbox(ctx context.Context, filter model.OutboxFilter, topic string, dryRun bool) ([]model.OutboxRecord, error)
+	ListOutboxMessages(ctx context.Context, filter model.OutboxMessageFilter) ([]model.OutboxMessage, error)
+	ReplayOutboxMessages(ctx context.Context, filter model.OutboxMessageFilter, dryRun bool) ([]model.OutboxMessage, error)
+}
+
+type AdminController struct {
+	servicepb.UnimplementedCommentsAdminServer
+	outboxAdminService OutboxAdminService
+}
+
+func NewAdminController(outboxAdminService OutboxAdminService) *AdminController {
+	return &AdminController{
+		outboxAdminService: outboxAdminService,
+	}
+}
+
+func (s *AdminController) ListOutbox(ctx context.Context, in *servicepb.ListOutboxRequest) (*servicepb.ListOutboxResponse, error) {
+	records, err := s.outboxAdminService.ListOutbox(ctx, toOutboxFilter(in.Filter))
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.ListOutboxResponse{
+		Notifications: toOutboxNotifications(records),
+	}, nil
+}
+
+func (s *AdminController) ReplayOutbox(ctx context.Context, in *servicepb.ReplayOutboxRequest) (*servicepb.ReplayOutboxResponse, error) {
+	records, err := s.outboxAdminService.ReplayOutbox(ctx, toOutboxFilter(in.Filter), in.Topic, in.DryRun)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		if errors.Is(err, model.ErrUnknownOutboxTopic) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.ReplayOutboxResponse{
+		Notifications: toOutboxNotifications(records),
+		DryRun:        in.DryRun,
+	}, nil
+}
+
+func (s *AdminController) ListOutboxMessages(ctx context.Context, in *servicepb.ListOutboxMessagesRequest) (*servicepb.ListOutboxMessagesResponse, error) {
+	msgs, err := s.outboxAdminService.ListOutboxMessages(ctx, toOutboxMessageFilter(in.Filter))
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.ListOutboxMessagesResponse{
+		Messages: toOutboxMessages(msgs),
+	}, nil
+}
+
+func (s *AdminController) ReplayOutboxMessages(ctx context.Context, in *servicepb.ReplayOutboxMessagesRequest) (*servicepb.ReplayOutboxMessagesResponse, error) {
+	msgs, err := s.outboxAdminService.ReplayOutboxMessages(ctx, toOutboxMessageFilter(in.Filter), in.DryRun)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.ReplayOutboxMessagesResponse{
+		Messages: toOutboxMessages(msgs),
+		DryRun:   in.DryRun,
+	}, nil
+}
+
+func toOutboxFilter(in *servicepb.OutboxFilter) model.OutboxFilter {
+	if in == nil {
+		return model.OutboxFilter{}
+	}
+	filter := model.OutboxFilter{
+		Status: in.Status,
+		FromID: in.FromID,
+		ToID:   in.ToID,
+		Limit:  in.Limit,
+	}
+	if in.From != nil {
+		filter.From = in.From.AsTime()
+	}
+	if in.To != nil {
+		filter.To = in.To.AsTime()
+	}
+	return filter
+}
+
+func toOutboxNotifications(records []model.OutboxRecord) []*servicepb.OutboxNotification {
+	res := make([]*servicepb.OutboxNotification, len(records))
+	for i, val := range records {
+		res[i] = &servicepb.OutboxNotification{
+			NotificationID: val.ID,
+			CommentID:      val.CommentID,
+			ProductID:      val.ProductID,
+			RecipientID:    val.RecipientID,
+			Reason:         val.Reason,
+			Status:         val.Status,
+			TraceParent:    val.TraceParent,
+			CreatedAt:      timestamppb.New(val.CreatedAt),
+			SentAt:         optionalTimestamp(val.SentAt),
+			Payload:        string(val.Payload),
+		}
+	}
+	return res
+}
+
+func toOutboxMessageFilter(in *servicepb.OutboxMessageFilter) model.OutboxMessageFilter {
+	if in == nil {
+		return model.OutboxMessageFilter{}
+	}
+	return model.OutboxMessageFilter{
+		Status: in.Status,
+		Topic:  in.Topic,
+		FromID: in.FromID,
+		ToID:   in.ToID,
+		Limit:  in.Limit,
+	}
+}
+
+func toOutboxMessages(msgs []model.OutboxMessage) []*servicepb.OutboxMessage {
+	res := make([]*servicepb.OutboxMessage, len(msgs))
+	for i, val := range msgs {
+		res[i] = &servicepb.OutboxMessage{
+			Id:        val.ID,
+			Topic:     val.Topic,
+			Key:       val.Key,
+			Headers:   val.Headers,
+			Status:    val.Status,
+			CreatedAt: timestamppb.New(val.CreatedAt),
+			SentAt:    optionalTimestamp(val.SentAt),
+			Payload:   string(val.Payload),
+		}
+	}
+	return res
+}
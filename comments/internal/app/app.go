@@ -2,25 +2,37 @@ package app
 
 import (
 	"context"
+	"errors"
 	"example/comments/internal/app/config"
 	"example/comments/internal/app/middlewares"
+	"example/comments/internal/events"
+	"example/comments/internal/external/batch"
+	"example/comments/internal/external/cache"
+	"example/comments/internal/external/client"
+	mwc "example/comments/internal/external/middlewares"
 	"example/comments/internal/external/notification"
 	"example/comments/internal/external/products"
 	"example/comments/internal/external/users"
+	"example/comments/internal/health"
 	"example/comments/internal/logger"
+	"example/comments/internal/outbox"
+	"example/comments/internal/receipts"
 	"example/comments/internal/repository"
 	"example/comments/internal/trace"
 	"example/comments/internal/usecases"
 	"fmt"
+	"maps"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
 	"syscall"
 	"time"
 
 	desc "example/comments/pkg/api/comments/v1"
 
+	"github.com/IBM/sarama"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -28,13 +40,28 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+var errTopicsNotVerified = errors.New("kafka topics are not verified yet")
+
+const (
+	topicsRecheckInterval = 10 * time.Second
+	// healthSyncInterval is how often the gRPC health status is updated from the readiness checks
+	healthSyncInterval = 5 * time.Second
+)
+
 type App struct {
 	config        *config.Config
+	health        *health.Registry
 	rep           *repository.Repository
+	products      *products.ProductService
+	users         *users.UserService
 	grpcServer    *grpc.Server
+	adminServer   *grpc.Server
+	grpcHealth    *grpchealth.Server
 	gwServer      *http.Server
 	metricsServer *http.Server
 }
@@ -47,19 +74,239 @@ func NewApp(ctx context.Context, configPath string) (*App, error) {
 
 	app := &App{
 		config: configImpl,
+		health: health.NewRegistry(),
 	}
 	appCtx, cancel := context.WithCancel(ctx)
+	trace.CreateTracerProvider(appCtx, configImpl)
 	app.ConnectDatabase(appCtx, configImpl.NotificationConf.MaxCount)
-	notification.StartNotificationService(appCtx, app.rep,
-		[]string{app.config.KafkaConf.Brokers},
-		app.config.KafkaConf.OrderTopic,
-		app.config.NotificationConf.MaxCount,
-		app.config.NotificationConf.Timer)
+	if err = app.ConnectExternal(appCtx); err != nil {
+		cancel()
+		return nil, err
+	}
+	app.StartTopicsVerification(appCtx)
+	notification.NewNotificationScheduler(app.rep,
+		time.Duration(app.config.NotificationConf.Timer)*time.Millisecond).Start(appCtx)
+	notification.StartWebhookService(appCtx, app.rep, notification.WebhookConfig{
+		Timer:                time.Duration(app.config.WebhookConf.Timer) * time.Millisecond,
+		Timeout:              time.Duration(app.config.WebhookConf.Timeout) * time.Millisecond,
+		Backoff:              time.Duration(app.config.WebhookConf.Backoff) * time.Millisecond,
+		MaxAttempts:          app.config.WebhookConf.MaxAttempts,
+		Lease:                time.Duration(app.config.WebhookConf.Lease) * time.Millisecond,
+		AllowPrivateNetworks: app.config.WebhookConf.AllowPrivateNetworks,
+	})
+	notification.StartFanOutService(appCtx, app.rep, notification.FanOutConfig{
+		Timer:     time.Duration(app.config.FanOutConf.Timer) * time.Millisecond,
+		BatchSize: app.config.FanOutConf.BatchSize,
+	})
+	app.StartOutboxPublisher(appCtx)
+	if app.config.ReceiptsConf.Topic != "" {
+		err = receipts.StartConsumer(appCtx, notification.NewKafkaConfig(app.config),
+			app.config.ReceiptsConf.Topic,
+			app.config.ReceiptsConf.GroupID,
+			app.config.ReceiptsConf.Consumer,
+			receipts.NewService(app.rep))
+		if err != nil {
+			logger.Warnw(appCtx, "receipts consumer is not started", "error", err.Error())
+		}
+	}
+	app.StartEventsConsumer(appCtx)
+	app.StartPendingValidator(appCtx)
 	app.SignalHandler(ctx, cancel)
-	trace.CreateTracerProvider(appCtx, configImpl)
 	return app, nil
 }
 
+// ConnectExternal creates the clients of the products and users services.
+func (app *App) ConnectExternal(appCtx context.Context) error {
+	productsService, err := products.NewProductsService(appCtx, clientConfig(app.config.ProductsConf))
+	if err != nil {
+		return err
+	}
+	usersService, err := users.NewUsersService(appCtx, clientConfig(app.config.UsersConf))
+	if err != nil {
+		return err
+	}
+	app.products = productsService
+	app.users = usersService
+	// in degraded mode comments are accepted without the services, so the process stays ready
+	critical := !app.config.DegradedConf.Enabled
+	app.health.AddCheck("external_products", critical, productsService.Ready)
+	app.health.AddCheck("external_users", critical, usersService.Ready)
+	return nil
+}
+
+func clientConfig(conf config.ClientConf) client.Config {
+	ms := func(val int) time.Duration {
+		return time.Duration(val) * time.Millisecond
+	}
+	endpoints := conf.Endpoints
+	if len(endpoints) == 0 {
+		endpoints = []string{fmt.Sprintf("%s:%s", conf.Host, conf.Port)}
+	}
+	return client.Config{
+		Endpoints:   endpoints,
+		Discovery:   conf.Discovery,
+		Balancing:   conf.Balancing,
+		HealthCheck: conf.HealthCheck,
+		Outlier: client.OutlierConfig{
+			Failures:           conf.Outlier.Failures,
+			EjectionTime:       ms(conf.Outlier.EjectionTime),
+			MaxEjectionTime:    ms(conf.Outlier.MaxEjectionTime),
+			MaxEjectionPercent: conf.Outlier.MaxEjectionPercent,
+		},
+		Timeout: ms(conf.Timeout),
+		Retry: mwc.RetryConfig{
+			Attempts:       conf.Retry.Attempts,
+			InitialBackoff: ms(conf.Retry.InitialBackoff),
+			MaxBackoff:     ms(conf.Retry.MaxBackoff),
+			AttemptTimeout: ms(conf.Retry.AttemptTimeout),
+		},
+		Breaker: mwc.BreakerConfig{
+			Failures:    conf.Breaker.Failures,
+			OpenTimeout: ms(conf.Breaker.OpenTimeout),
+		},
+		Batch: batch.Config{
+			Window:  ms(conf.BatchWindow),
+			MaxSize: conf.BatchSize,
+		},
+		Cache: cache.Config{
+			TTL:         ms(conf.CacheTTL),
+			NegativeTTL: ms(conf.CacheNegativeTTL),
+			MaxSize:     conf.CacheSize,
+		},
+	}
+}
+
+// StartPendingValidator validates the comments accepted in degraded mode. It runs even if the
+// mode is off, so the comments accepted before it was turned off are still handled.
+func (app *App) StartPendingValidator(appCtx context.Context) {
+	createCommentService := usecases.NewCreateCommentService(app.rep, app.products, app.users, app.degradedConfig())
+	usecases.NewPendingValidator(app.rep, createCommentService).Start(appCtx)
+}
+
+func (app *App) degradedConfig() usecases.DegradedConfig {
+	return usecases.DegradedConfig{
+		Enabled:    app.config.DegradedConf.Enabled,
+		Timer:      time.Duration(app.config.DegradedConf.Timer) * time.Millisecond,
+		BatchSize:  app.config.DegradedConf.BatchSize,
+		Backoff:    time.Duration(app.config.DegradedConf.Backoff) * time.Millisecond,
+		MaxBackoff: time.Duration(app.config.DegradedConf.MaxBackoff) * time.Millisecond,
+	}
+}
+
+// StartEventsConsumer consumes the events of other services about entities comments refer to.
+func (app *App) StartEventsConsumer(appCtx context.Context) {
+	handlers := make(map[string]events.Handler)
+	if app.config.EventsConf.ProductsTopic != "" {
+		handlers[app.config.EventsConf.ProductsTopic] = events.NewProductsHandler(app.rep, app.products)
+	}
+	if app.config.EventsConf.UsersTopic != "" {
+		handlers[app.config.EventsConf.UsersTopic] = events.NewUsersHandler(app.rep, app.users)
+	}
+	if len(handlers) == 0 {
+		return
+	}
+	err := events.StartConsumer(appCtx, notification.NewKafkaConfig(app.config), app.config.EventsConf.GroupID,
+		app.config.EventsConf.Consumer, handlers)
+	if err != nil {
+		logger.Warnw(appCtx, "events consumer is not started", "error", err.Error())
+	}
+}
+
+// StartTopicsVerification verifies the topics the service produces to and consumes from in
+// background, so startup does not wait for Kafka. Until they are verified the service is not
+// ready, the verification is repeated every topicsRecheckInterval, so creating the topics by
+// hand makes the service ready without restart.
+func (app *App) StartTopicsVerification(appCtx context.Context) {
+	kafkaConf := notification.NewKafkaConfig(app.config)
+	topics := app.topics()
+	app.health.Set("kafka_topics", errTopicsNotVerified)
+	verify := func() bool {
+		err := notification.EnsureTopics(appCtx, kafkaConf, kafkaConf.Topics, topics)
+		app.health.Set("kafka_topics", err)
+		if err != nil {
+			logger.Errorw(appCtx, "kafka topics verification failed", "error", err.Error())
+			return false
+		}
+		return true
+	}
+	go func() {
+		if verify() {
+			return
+		}
+		ticker := time.NewTicker(topicsRecheckInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-appCtx.Done():
+				return
+			case <-ticker.C:
+				if verify() {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// topics returns the notification topic, the Kafka topics of outbox routes and the consumed topics.
+func (app *App) topics() []string {
+	topics := []string{app.config.KafkaConf.OrderTopic}
+	others := []string{app.config.ReceiptsConf.Topic, app.config.EventsConf.ProductsTopic, app.config.EventsConf.UsersTopic}
+	if app.config.ReceiptsConf.Topic != "" {
+		others = append(others, app.config.ReceiptsConf.Consumer.DeadLetterTopic)
+	}
+	if app.config.EventsConf.ProductsTopic != "" || app.config.EventsConf.UsersTopic != "" {
+		others = append(others, app.config.EventsConf.Consumer.DeadLetterTopic)
+	}
+	for _, topic := range app.config.OutboxConf.Routes {
+		others = append(others, topic)
+	}
+	for _, topic := range others {
+		if topic != "" && !slices.Contains(topics, topic) {
+			topics = append(topics, topic)
+		}
+	}
+	slices.Sort(topics[1:])
+	return topics
+}
+
+// StartOutboxPublisher publishes the outbox, notifications included, with the only producer of
+// the service. Notifications are routed to kafka.order_topic.
+func (app *App) StartOutboxPublisher(appCtx context.Context) {
+	kafkaConf := notification.NewProducerConfig(app.config)
+	outbox.StartPublisher(appCtx, app.rep, app.startProducer(appCtx, "outbox", kafkaConf), outbox.Config{
+		Timer:         time.Duration(app.config.OutboxConf.Timer) * time.Millisecond,
+		BatchSize:     app.config.OutboxConf.BatchSize,
+		Timeout:       time.Duration(app.config.OutboxConf.Timeout) * time.Millisecond,
+		Lease:         time.Duration(app.config.OutboxConf.Lease) * time.Millisecond,
+		Routes:        app.outboxRoutes(),
+		Transactional: kafkaConf.Transactional(),
+	})
+}
+
+func (app *App) outboxRoutes() map[string]string {
+	routes := map[string]string{notification.TopicNotifications: app.config.KafkaConf.OrderTopic}
+	for topic, route := range app.config.OutboxConf.Routes {
+		routes[topic] = route
+	}
+	return routes
+}
+
+// startProducer starts a supervised producer reporting its state to readiness.
+func (app *App) startProducer(appCtx context.Context, name string, kafkaConf notification.ProducerConfig) *outbox.ProducerSupervisor {
+	producers := outbox.NewProducerSupervisor(name,
+		func() (sarama.SyncProducer, error) {
+			return notification.NewSyncProducer(kafkaConf)
+		},
+		outbox.BackoffConfig{
+			Initial: time.Duration(app.config.KafkaConf.Reconnect.InitialBackoff) * time.Millisecond,
+			Max:     time.Duration(app.config.KafkaConf.Reconnect.MaxBackoff) * time.Millisecond,
+		},
+		app.health)
+	producers.Start(appCtx)
+	return producers
+}
+
 func (app *App) ConnectDatabase(appCtx context.Context, ntfMaxCount int) {
 	address := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
 		app.config.DBConf.User, app.config.DBConf.Password, app.config.DBConf.Host, app.config.DBConf.Port, app.config.DBConf.DBName)
@@ -74,6 +321,7 @@ func (app *App) ConnectDatabase(appCtx context.Context, ntfMaxCount int) {
 		panic(err)
 	}
 
+	app.health.AddCheck("postgres", true, masterPool.Ping)
 	app.rep = repository.NewRepository(masterPool, ntfMaxCount)
 }
 
@@ -95,22 +343,25 @@ func (app *App) ListenAndServe(ctx context.Context) error {
 	)
 
 	reflection.Register(app.grpcServer)
+	app.grpcHealth = grpchealth.NewServer()
+	healthpb.RegisterHealthServer(app.grpcServer, app.grpcHealth)
+	app.startHealthSync(ctx)
 
-	productsAddress := fmt.Sprintf("%s:%s", app.config.ProductsConf.Host, app.config.ProductsConf.Port)
-	productsService, err := products.NewProductsService(ctx, productsAddress)
-	if err != nil {
-		return err
-	}
-
-	usersAddress := fmt.Sprintf("%s:%s", app.config.UsersConf.Host, app.config.UsersConf.Port)
-	usersService, err := users.NewUsersService(ctx, usersAddress)
-	if err != nil {
-		return err
-	}
-
-	createCommentService := usecases.NewCreateCommentService(app.rep, productsService, usersService)
-	getCommentsService := usecases.NewGetCommentsService(app.rep)
-	commentsController := NewCommentsController(createCommentService, getCommentsService)
+	createCommentService := usecases.NewCreateCommentService(app.rep, app.products, app.users, app.degradedConfig())
+	getCommentsService := usecases.NewGetCommentsService(app.rep, app.users)
+	registerWebhookService := usecases.NewRegisterWebhookService(app.rep, app.config.WebhookConf.AllowPrivateNetworks)
+	getWebhookDeliveriesService := usecases.NewGetWebhookDeliveriesService(app.rep)
+	setNotificationPreferencesService := usecases.NewSetNotificationPreferencesService(app.rep)
+	getNotificationPreferencesService := usecases.NewGetNotificationPreferencesService(app.rep)
+	setNotificationChannelService := usecases.NewSetNotificationChannelService(app.rep, app.config.WebhookConf.AllowPrivateNetworks)
+	getNotificationStatusService := usecases.NewGetNotificationStatusService(app.rep)
+	subscribeService := usecases.NewSubscribeService(app.rep, app.products, app.users)
+	listSubscriptionsService := usecases.NewListSubscriptionsService(app.rep)
+	commentsController := NewCommentsController(createCommentService, getCommentsService,
+		registerWebhookService, getWebhookDeliveriesService,
+		setNotificationPreferencesService, getNotificationPreferencesService,
+		setNotificationChannelService, getNotificationStatusService,
+		subscribeService, listSubscriptionsService)
 	desc.RegisterCommentsServer(app.grpcServer, commentsController)
 
 	logger.Infow(ctx, "server listening", "address", list.Addr())
@@ -120,12 +371,18 @@ func (app *App) ListenAndServe(ctx context.Context) error {
 		}
 	}()
 
+	if err = app.ServeAdmin(ctx); err != nil {
+		return err
+	}
+
 	go func() {
 		address := fmt.Sprintf("%s:%s", app.config.ServiceConf.Host, app.config.ServiceConf.MetricPort)
 		logger.Infow(context.Background(), "Starting metric loms service", "address", address)
 		l, _ := net.Listen("tcp", address)
 		mx := http.NewServeMux()
 		mx.Handle("GET /metrics", promhttp.Handler())
+		mx.Handle("GET /healthz", health.LiveHandler())
+		mx.Handle("GET /readyz", app.health.ReadyHandler())
 		app.metricsServer = &http.Server{}
 		app.metricsServer.Handler = mx
 		if err = app.metricsServer.Serve(l); err != nil {
@@ -142,6 +399,61 @@ func (app *App) ListenAndServe(ctx context.Context) error {
 	return nil
 }
 
+// startHealthSync serves the readiness checks over grpc.health.v1, for the whole server and
+// for each of its services.
+func (app *App) startHealthSync(ctx context.Context) {
+	update := func() {
+		status := healthpb.HealthCheckResponse_SERVING
+		if rep := app.health.Check(ctx); !rep.Ready() {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			logger.Warnw(ctx, "comments service is not ready", "components", rep.Components)
+		}
+		for _, service := range []string{"", desc.Comments_ServiceDesc.ServiceName} {
+			app.grpcHealth.SetServingStatus(service, status)
+		}
+	}
+	update()
+	go func() {
+		ticker := time.NewTicker(healthSyncInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				update()
+			}
+		}
+	}()
+}
+
+// ServeAdmin serves CommentsAdmin on its own listener, so the admin methods are reachable only
+// where service.admin_host:service.admin_port is, and never through the public gRPC port.
+func (app *App) ServeAdmin(ctx context.Context) error {
+	address := fmt.Sprintf("%s:%s", app.config.ServiceConf.AdminHost, app.config.ServiceConf.AdminPort)
+	logger.Infow(ctx, "Starting admin grpc server", "address", address)
+	list, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("listen admin failed: %w", err)
+	}
+	app.adminServer = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			mw.Panic,
+			mw.Logger,
+			mw.Trace,
+			mw.Validate,
+		),
+	)
+	reflection.Register(app.adminServer)
+	desc.RegisterCommentsAdminServer(app.adminServer, NewAdminController(usecases.NewOutboxAdminService(app.rep, slices.Collect(maps.Keys(app.outboxRoutes())))))
+	go func() {
+		if err := app.adminServer.Serve(list); err != nil {
+			panic(err)
+		}
+	}()
+	return nil
+}
+
 func (app *App) CreateHTTPGateway(ctx context.Context) error {
 	address := fmt.Sprintf("%s:%s", app.config.ServiceConf.Host, app.config.ServiceConf.HTTPPort)
 	logger.Infow(ctx, "Starting gateway", "address", address)
@@ -186,7 +498,10 @@ func (app *App) SignalHandler(ctx context.Context, appCancelContext func()) {
 		if err != nil {
 			logger.Infow(sdCtx, "can not shutdown server", "err", err.Error())
 		}
+		// the clients see NOT_SERVING and move to other replicas before the server stops
+		app.grpcHealth.Shutdown()
 		app.grpcServer.GracefulStop()
+		app.adminServer.GracefulStop()
 		appCancelContext()
 	}(appCancelContext)
 }
@@ -14,26 +14,54 @@ import (
 var _ servicepb.CommentsServer = (*CommentsController)(nil)
 
 type CreateCommentService interface {
-	CreateComment(ctx context.Context, comment model.Comment) (int64, error)
+	CreateComment(ctx context.Context, comment model.Comment) (int64, string, error)
 }
 
 type GetCommentsService interface {
 	GetComments(ctx context.Context, productID int64) ([]model.Comment, error)
+	GetCommentsWithAuthors(ctx context.Context, productID int64) ([]model.Comment, bool, error)
 }
 
+// expandAuthor is the GetComments expand mode that adds the author profiles.
+const expandAuthor = "author"
+
 type CommentsController struct {
 	servicepb.UnimplementedCommentsServer
-	createCommentService CreateCommentService
-	getCommentsService   GetCommentsService
+	createCommentService              CreateCommentService
+	getCommentsService                GetCommentsService
+	registerWebhookService            RegisterWebhookService
+	getWebhookDeliveriesService       GetWebhookDeliveriesService
+	setNotificationPreferencesService SetNotificationPreferencesService
+	getNotificationPreferencesService GetNotificationPreferencesService
+	setNotificationChannelService     SetNotificationChannelService
+	getNotificationStatusService      GetNotificationStatusService
+	subscribeService                  SubscribeService
+	listSubscriptionsService          ListSubscriptionsService
 }
 
 func NewCommentsController(createCommentService CreateCommentService,
 	getCommentsService GetCommentsService,
+	registerWebhookService RegisterWebhookService,
+	getWebhookDeliveriesService GetWebhookDeliveriesService,
+	setNotificationPreferencesService SetNotificationPreferencesService,
+	getNotificationPreferencesService GetNotificationPreferencesService,
+	setNotificationChannelService SetNotificationChannelService,
+	getNotificationStatusService GetNotificationStatusService,
+	subscribeService SubscribeService,
+	listSubscriptionsService ListSubscriptionsService,
 ) *CommentsController {
 
 	return &CommentsController{
-		createCommentService: createCommentService,
-		getCommentsService:   getCommentsService,
+		createCommentService:              createCommentService,
+		getCommentsService:                getCommentsService,
+		registerWebhookService:            registerWebhookService,
+		getWebhookDeliveriesService:       getWebhookDeliveriesService,
+		setNotificationPreferencesService: setNotificationPreferencesService,
+		getNotificationPreferencesService: getNotificationPreferencesService,
+		setNotificationChannelService:     setNotificationChannelService,
+		getNotificationStatusService:      getNotificationStatusService,
+		subscribeService:                  subscribeService,
+		listSubscriptionsService:          listSubscriptionsService,
 	}
 }
 
@@ -41,12 +69,14 @@ func (s *CommentsController) CreateComment(ctx context.Context, in *servicepb.Cr
 	comment := model.Comment{
 		UserID:    in.UserID,
 		ProductID: in.ProductID,
+		ParentID:  in.ParentID,
 		Text:      in.Text,
 	}
-	commentID, err := s.createCommentService.CreateComment(ctx, comment)
+	commentID, commentStatus, err := s.createCommentService.CreateComment(ctx, comment)
 	if err != nil {
 		logger.Warnw(ctx, "Request failed", "error", err)
-		if errors.Is(err, model.ErrIncorrectUserID) || errors.Is(err, model.ErrProductOwnerNotFound) {
+		if errors.Is(err, model.ErrIncorrectUserID) || errors.Is(err, model.ErrProductOwnerNotFound) ||
+			errors.Is(err, model.ErrParentCommentNotFound) {
 			return nil, status.Error(codes.FailedPrecondition, "Invalid request")
 		}
 		if errors.Is(err, model.ErrProductServiceUnavailable) || errors.Is(err, model.ErrUserServiceUnavailable) {
@@ -56,12 +86,22 @@ func (s *CommentsController) CreateComment(ctx context.Context, in *servicepb.Cr
 	}
 	res := &servicepb.CreateCommentResponse{
 		CommentID: commentID,
+		Status:    commentStatus,
 	}
 	return res, nil
 }
 
 func (s *CommentsController) GetComments(ctx context.Context, in *servicepb.GetCommentsRequest) (*servicepb.GetCommentsResponse, error) {
-	comments, err := s.getCommentsService.GetComments(ctx, in.ProductID)
+	var (
+		comments []model.Comment
+		authors  = true
+		err      error
+	)
+	if in.Expand == expandAuthor {
+		comments, authors, err = s.getCommentsService.GetCommentsWithAuthors(ctx, in.ProductID)
+	} else {
+		comments, err = s.getCommentsService.GetComments(ctx, in.ProductID)
+	}
 	if err != nil {
 		logger.Warnw(ctx, "Request failed", "error", err)
 		return nil, status.Error(codes.Internal, "Internal error")
@@ -69,15 +109,23 @@ func (s *CommentsController) GetComments(ctx context.Context, in *servicepb.GetC
 	commentsResponse := make([]*servicepb.Comment, len(comments))
 	for i, val := range comments {
 		commentsResponse[i] = &servicepb.Comment{
-			ID:     val.ID,
-			UserID: val.UserID,
-			Text:   val.Text,
-			Ts:     timestamppb.New(val.Ts),
+			ID:       val.ID,
+			UserID:   val.UserID,
+			Text:     val.Text,
+			Ts:       timestamppb.New(val.Ts),
+			ParentID: val.ParentID,
+		}
+		if val.Author != nil {
+			commentsResponse[i].Author = &servicepb.Author{
+				DisplayName: val.Author.DisplayName,
+				AvatarURL:   val.Author.AvatarURL,
+			}
 		}
 	}
 	res := &servicepb.GetCommentsResponse{
-		ProductID: in.ProductID,
-		Comments:  commentsResponse,
+		ProductID:          in.ProductID,
+		Comments:           commentsResponse,
+		AuthorsUnavailable: !authors,
 	}
 	return res, nil
 }
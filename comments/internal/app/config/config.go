@@ -14,6 +14,9 @@ type Config struct {
 		GRPCPort   string `yaml:"grpc_port"`
 		HTTPPort   string `yaml:"http_port"`
 		MetricPort string `yaml:"metric_port"`
+		// AdminHost and AdminPort serve CommentsAdmin, the listener must not be published
+		AdminHost string `yaml:"admin_host"`
+		AdminPort string `yaml:"admin_port"`
 	} `yaml:"service"`
 
 	NotificationConf struct {
@@ -21,19 +24,100 @@ type Config struct {
 		Timer    int `yaml:"timer"`
 	} `yaml:"notification"`
 
-	ProductsConf struct {
-		Host string `yaml:"host"`
-		Port string `yaml:"port"`
-	} `yaml:"products"`
+	WebhookConf struct {
+		Timer       int `yaml:"timer"`
+		Timeout     int `yaml:"timeout"`
+		Backoff     int `yaml:"backoff"`
+		MaxAttempts int `yaml:"max_attempts"`
+		// Lease hides claimed tasks from other replicas while they are delivered
+		Lease int `yaml:"lease"`
+		// AllowPrivateNetworks permits webhooks to loopback and private addresses, for local environments only
+		AllowPrivateNetworks bool `yaml:"allow_private_networks"`
+	} `yaml:"webhook"`
 
-	UsersConf struct {
-		Host string `yaml:"host"`
-		Port string `yaml:"port"`
-	} `yaml:"users"`
+	FanOutConf struct {
+		Timer     int `yaml:"timer"`
+		BatchSize int `yaml:"batch_size"`
+	} `yaml:"fanout"`
+
+	OutboxConf struct {
+		Timer     int               `yaml:"timer"`
+		BatchSize int               `yaml:"batch_size"`
+		Timeout   int               `yaml:"timeout"`
+		Lease     int               `yaml:"lease"`
+		Routes    map[string]string `yaml:"routes"`
+	} `yaml:"outbox"`
+
+	NotifierConf struct {
+		Topic          string `yaml:"topic"`
+		GroupID        string `yaml:"group_id"`
+		DefaultChannel string `yaml:"default_channel"`
+		DefaultLocale  string `yaml:"default_locale"`
+		WebhookTimeout int    `yaml:"webhook_timeout"`
+		SMTP           struct {
+			Host string `yaml:"host"`
+			Port string `yaml:"port"`
+			From string `yaml:"from"`
+		} `yaml:"smtp"`
+		Consumer ConsumerConf `yaml:"consumer"`
+	} `yaml:"notifier"`
+
+	ReceiptsConf struct {
+		Topic    string       `yaml:"topic"`
+		GroupID  string       `yaml:"group_id"`
+		Consumer ConsumerConf `yaml:"consumer"`
+	} `yaml:"receipts"`
+
+	EventsConf struct {
+		GroupID       string       `yaml:"group_id"`
+		ProductsTopic string       `yaml:"products_topic"`
+		UsersTopic    string       `yaml:"users_topic"`
+		Consumer      ConsumerConf `yaml:"consumer"`
+	} `yaml:"events"`
+
+	// DegradedConf accepts comments pending validation while the users or products
+	// service is unavailable, durations are in ms
+	DegradedConf struct {
+		Enabled    bool `yaml:"enabled"`
+		Timer      int  `yaml:"timer"`
+		BatchSize  int  `yaml:"batch_size"`
+		Backoff    int  `yaml:"backoff"`
+		MaxBackoff int  `yaml:"max_backoff"`
+	} `yaml:"degraded"`
+
+	ProductsConf ClientConf `yaml:"products"`
+
+	UsersConf ClientConf `yaml:"users"`
 
 	KafkaConf struct {
-		OrderTopic string `yaml:"order_topic"`
-		Brokers    string `yaml:"brokers"`
+		OrderTopic      string `yaml:"order_topic"`
+		Brokers         string `yaml:"brokers"`
+		Idempotent      bool   `yaml:"idempotent"`
+		TransactionalID string `yaml:"transactional_id"`
+		SASL            struct {
+			Enabled   bool   `yaml:"enabled"`
+			Mechanism string `yaml:"mechanism"`
+			User      string `yaml:"user"`
+			Password  string `yaml:"password"`
+		} `yaml:"sasl"`
+		TLS struct {
+			Enabled            bool   `yaml:"enabled"`
+			CAFile             string `yaml:"ca_file"`
+			CertFile           string `yaml:"cert_file"`
+			KeyFile            string `yaml:"key_file"`
+			InsecureSkipVerify bool   `yaml:"insecure_skip_verify"`
+		} `yaml:"tls"`
+		Topics struct {
+			Verify            bool  `yaml:"verify"`
+			Create            bool  `yaml:"create"`
+			Partitions        int32 `yaml:"partitions"`
+			ReplicationFactor int16 `yaml:"replication_factor"`
+			RetentionMs       int64 `yaml:"retention_ms"`
+		} `yaml:"topics"`
+		Reconnect struct {
+			InitialBackoff int `yaml:"initial_backoff"`
+			MaxBackoff     int `yaml:"max_backoff"`
+		} `yaml:"reconnect"`
 	} `yaml:"kafka"`
 
 	JaegerConf struct {
@@ -50,6 +134,54 @@ type Config struct {
 	} `yaml:"postgres"`
 }
 
+// ConsumerConf is the retry policy of a consumer group, durations are in ms.
+type ConsumerConf struct {
+	// Attempts bounds the handling of a message failing with transient errors
+	Attempts       int `yaml:"attempts"`
+	InitialBackoff int `yaml:"initial_backoff"`
+	MaxBackoff     int `yaml:"max_backoff"`
+	// DeadLetterTopic receives the messages that failed, they are skipped if it is empty
+	DeadLetterTopic string `yaml:"dead_letter_topic"`
+}
+
+// ClientConf is the config of an external service client, durations are in ms.
+type ClientConf struct {
+	Host string `yaml:"host"`
+	Port string `yaml:"port"`
+	// Endpoints are host:port of the replicas, Host and Port are used if it is empty
+	Endpoints []string `yaml:"endpoints"`
+	// Discovery is dns or static
+	Discovery string `yaml:"discovery"`
+	// Balancing is round_robin or pick_first
+	Balancing   string `yaml:"balancing"`
+	HealthCheck bool   `yaml:"health_check"`
+	Outlier     struct {
+		Failures           int `yaml:"failures"`
+		EjectionTime       int `yaml:"ejection_time"`
+		MaxEjectionTime    int `yaml:"max_ejection_time"`
+		MaxEjectionPercent int `yaml:"max_ejection_percent"`
+	} `yaml:"outlier"`
+	// Timeout bounds a call with all its retries
+	Timeout int `yaml:"timeout"`
+	Retry   struct {
+		Attempts       int `yaml:"attempts"`
+		InitialBackoff int `yaml:"initial_backoff"`
+		MaxBackoff     int `yaml:"max_backoff"`
+		AttemptTimeout int `yaml:"attempt_timeout"`
+	} `yaml:"retry"`
+	Breaker struct {
+		Failures    int `yaml:"failures"`
+		OpenTimeout int `yaml:"open_timeout"`
+	} `yaml:"breaker"`
+	// BatchWindow 0 turns the coalescing of lookups off
+	BatchWindow int `yaml:"batch_window"`
+	BatchSize   int `yaml:"batch_size"`
+	// CacheTTL 0 turns the cache off
+	CacheTTL         int `yaml:"cache_ttl"`
+	CacheNegativeTTL int `yaml:"cache_negative_ttl"`
+	CacheSize        int `yaml:"cache_size"`
+}
+
 func LoadConfig(filename string) (*Config, error) {
 	f, err := os.Open(filepath.Clean(filename))
 	if err != nil {
@@ -61,11 +193,78 @@ func LoadConfig(filename string) (*Config, error) {
 	}(f)
 
 	config := &Config{}
+	config.ServiceConf.MetricPort = "8085"
+	config.ServiceConf.AdminHost = "127.0.0.1"
+	config.ServiceConf.AdminPort = "8086"
 	config.NotificationConf.MaxCount = 100
 	config.NotificationConf.Timer = 300
+	config.WebhookConf.Timer = 1000
+	config.WebhookConf.Timeout = 3000
+	config.WebhookConf.Backoff = 500
+	config.WebhookConf.MaxAttempts = 5
+	config.WebhookConf.Lease = 60000
+	config.FanOutConf.Timer = 500
+	config.FanOutConf.BatchSize = 1000
+	config.OutboxConf.Timer = 300
+	config.OutboxConf.BatchSize = 100
+	config.OutboxConf.Timeout = 1000
+	config.OutboxConf.Lease = 10000
+	config.NotifierConf.GroupID = "comments-notifier"
+	config.NotifierConf.DefaultChannel = "log"
+	config.NotifierConf.DefaultLocale = "ru"
+	config.NotifierConf.WebhookTimeout = 3000
+	config.NotifierConf.Consumer = defaultConsumerConf()
+	config.ReceiptsConf.GroupID = "comments-receipts"
+	config.ReceiptsConf.Consumer = defaultConsumerConf()
+	config.EventsConf.GroupID = "comments-events"
+	config.EventsConf.Consumer = defaultConsumerConf()
+	config.DegradedConf.Timer = 1000
+	config.DegradedConf.BatchSize = 100
+	config.DegradedConf.Backoff = 5000
+	config.DegradedConf.MaxBackoff = 300000
+	config.ProductsConf = defaultClientConf()
+	config.UsersConf = defaultClientConf()
+	config.KafkaConf.Topics.Verify = true
+	config.KafkaConf.Topics.Partitions = 1
+	config.KafkaConf.Topics.ReplicationFactor = 1
+	config.KafkaConf.Reconnect.InitialBackoff = 500
+	config.KafkaConf.Reconnect.MaxBackoff = 30000
 	if err := yaml.NewDecoder(f).Decode(config); err != nil {
 		return nil, err
 	}
 
 	return config, nil
 }
+
+func defaultClientConf() ClientConf {
+	conf := ClientConf{
+		Discovery:        "dns",
+		Balancing:        "round_robin",
+		HealthCheck:      true,
+		Timeout:          2000,
+		BatchWindow:      5,
+		BatchSize:        100,
+		CacheTTL:         60000,
+		CacheNegativeTTL: 5000,
+		CacheSize:        10000,
+	}
+	conf.Retry.Attempts = 3
+	conf.Retry.InitialBackoff = 50
+	conf.Retry.MaxBackoff = 500
+	conf.Retry.AttemptTimeout = 500
+	conf.Breaker.Failures = 5
+	conf.Breaker.OpenTimeout = 10000
+	conf.Outlier.Failures = 5
+	conf.Outlier.EjectionTime = 10000
+	conf.Outlier.MaxEjectionTime = 60000
+	conf.Outlier.MaxEjectionPercent = 50
+	return conf
+}
+
+func defaultConsumerConf() ConsumerConf {
+	return ConsumerConf{
+		Attempts:       5,
+		InitialBackoff: 100,
+		MaxBackoff:     30000,
+	}
+}
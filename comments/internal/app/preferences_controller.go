@@ -0,0 +1,144 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	servicepb "example/comments/pkg/api/comments/v1"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SetNotificationPreferencesService interface {
+	SetNotificationPreferences(ctx context.Context, prefs model.NotificationPreferences) (model.NotificationPreferences, error)
+}
+
+type GetNotificationPreferencesService interface {
+	GetNotificationPreferences(ctx context.Context, ownerID int64) (model.NotificationPreferences, error)
+}
+
+type SetNotificationChannelService interface {
+	SetNotificationChannel(ctx context.Context, channel model.NotificationChannel) (model.NotificationChannel, error)
+}
+
+type GetNotificationStatusService interface {
+	GetNotificationStatus(ctx context.Context, commentID int64) ([]model.NotificationStatus, error)
+}
+
+var notificationModes = map[servicepb.NotificationMode]string{
+	servicepb.NotificationMode_NOTIFICATION_MODE_IMMEDIATE:     model.ModeImmediate,
+	servicepb.NotificationMode_NOTIFICATION_MODE_HOURLY_DIGEST: model.ModeHourlyDigest,
+	servicepb.NotificationMode_NOTIFICATION_MODE_DAILY_DIGEST:  model.ModeDailyDigest,
+}
+
+func (s *CommentsController) SetNotificationPreferences(ctx context.Context, in *servicepb.SetNotificationPreferencesRequest) (*servicepb.NotificationPreferences, error) {
+	prefs := model.NotificationPreferences{
+		OwnerID:         in.OwnerID,
+		Mode:            notificationModes[in.Mode],
+		MutedProductIDs: in.MutedProductIDs,
+		Timezone:        in.Timezone,
+	}
+	if in.QuietHours != nil {
+		prefs.QuietHours = &model.QuietHours{
+			Start: int(in.QuietHours.StartHour),
+			End:   int(in.QuietHours.EndHour),
+		}
+	}
+	saved, err := s.setNotificationPreferencesService.SetNotificationPreferences(ctx, prefs)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		if errors.Is(err, model.ErrInvalidTimezone) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return toNotificationPreferencesResponse(saved), nil
+}
+
+func (s *CommentsController) GetNotificationPreferences(ctx context.Context, in *servicepb.GetNotificationPreferencesRequest) (*servicepb.NotificationPreferences, error) {
+	prefs, err := s.getNotificationPreferencesService.GetNotificationPreferences(ctx, in.OwnerID)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return toNotificationPreferencesResponse(prefs), nil
+}
+
+func (s *CommentsController) SetNotificationChannel(ctx context.Context, in *servicepb.SetNotificationChannelRequest) (*servicepb.NotificationChannel, error) {
+	saved, err := s.setNotificationChannelService.SetNotificationChannel(ctx, model.NotificationChannel{
+		OwnerID: in.OwnerID,
+		Kind:    in.Channel,
+		Address: in.Address,
+		Locale:  in.Locale,
+		Secret:  in.Secret,
+	})
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		if errors.Is(err, model.ErrInvalidNotificationChannel) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.NotificationChannel{
+		OwnerID: saved.OwnerID,
+		Channel: saved.Kind,
+		Address: saved.Address,
+		Locale:  saved.Locale,
+	}, nil
+}
+
+func toNotificationPreferencesResponse(prefs model.NotificationPreferences) *servicepb.NotificationPreferences {
+	res := &servicepb.NotificationPreferences{
+		OwnerID:         prefs.OwnerID,
+		MutedProductIDs: prefs.MutedProductIDs,
+		Timezone:        prefs.Timezone,
+	}
+	for mode, name := range notificationModes {
+		if name == prefs.Mode {
+			res.Mode = mode
+		}
+	}
+	if prefs.QuietHours != nil {
+		res.QuietHours = &servicepb.QuietHours{
+			StartHour: int32(prefs.QuietHours.Start),
+			EndHour:   int32(prefs.QuietHours.End),
+		}
+	}
+	return res
+}
+
+func (s *CommentsController) GetNotificationStatus(ctx context.Context, in *servicepb.GetNotificationStatusRequest) (*servicepb.GetNotificationStatusResponse, error) {
+	statuses, err := s.getNotificationStatusService.GetNotificationStatus(ctx, in.CommentID)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	notifications := make([]*servicepb.NotificationStatus, len(statuses))
+	for i, val := range statuses {
+		notifications[i] = &servicepb.NotificationStatus{
+			NotificationID: val.ID,
+			RecipientID:    val.RecipientID,
+			Reason:         val.Reason,
+			Status:         val.Status,
+			CreatedAt:      timestamppb.New(val.CreatedAt),
+			SentAt:         optionalTimestamp(val.SentAt),
+			DeliveredAt:    optionalTimestamp(val.DeliveredAt),
+			ReadAt:         optionalTimestamp(val.ReadAt),
+		}
+	}
+	return &servicepb.GetNotificationStatusResponse{
+		CommentID:     in.CommentID,
+		Notifications: notifications,
+	}, nil
+}
+
+func optionalTimestamp(ts time.Time) *timestamppb.Timestamp {
+	if ts.IsZero() {
+		return nil
+	}
+	return timestamppb.New(ts)
+}
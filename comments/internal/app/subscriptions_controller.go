@@ -0,0 +1,69 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	servicepb "example/comments/pkg/api/comments/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type SubscribeService interface {
+	Subscribe(ctx context.Context, userID int64, productID int64) (bool, error)
+	Unsubscribe(ctx context.Context, userID int64, productID int64) (bool, error)
+}
+
+type ListSubscriptionsService interface {
+	ListSubscriptions(ctx context.Context, userID int64) ([]model.Subscription, error)
+}
+
+func (s *CommentsController) Subscribe(ctx context.Context, in *servicepb.SubscribeRequest) (*servicepb.SubscribeResponse, error) {
+	created, err := s.subscribeService.Subscribe(ctx, in.UserID, in.ProductID)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		if errors.Is(err, model.ErrIncorrectUserID) || errors.Is(err, model.ErrProductOwnerNotFound) {
+			return nil, status.Error(codes.FailedPrecondition, "Invalid request")
+		}
+		if errors.Is(err, model.ErrProductServiceUnavailable) || errors.Is(err, model.ErrUserServiceUnavailable) {
+			return nil, status.Error(codes.Unavailable, "External service unavailable")
+		}
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.SubscribeResponse{
+		Created: created,
+	}, nil
+}
+
+func (s *CommentsController) Unsubscribe(ctx context.Context, in *servicepb.UnsubscribeRequest) (*servicepb.UnsubscribeResponse, error) {
+	removed, err := s.subscribeService.Unsubscribe(ctx, in.UserID, in.ProductID)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.UnsubscribeResponse{
+		Removed: removed,
+	}, nil
+}
+
+func (s *CommentsController) ListSubscriptions(ctx context.Context, in *servicepb.ListSubscriptionsRequest) (*servicepb.ListSubscriptionsResponse, error) {
+	subscriptions, err := s.listSubscriptionsService.ListSubscriptions(ctx, in.UserID)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	subscriptionsResponse := make([]*servicepb.Subscription, len(subscriptions))
+	for i, val := range subscriptions {
+		subscriptionsResponse[i] = &servicepb.Subscription{
+			ProductID: val.ProductID,
+			CreatedAt: timestamppb.New(val.CreatedAt),
+		}
+	}
+	return &servicepb.ListSubscriptionsResponse{
+		UserID:        in.UserID,
+		Subscriptions: subscriptionsResponse,
+	}, nil
+}
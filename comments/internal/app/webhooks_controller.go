@@ -0,0 +1,65 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	servicepb "example/comments/pkg/api/comments/v1"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type RegisterWebhookService interface {
+	RegisterWebhook(ctx context.Context, webhook model.Webhook) (int64, error)
+}
+
+type GetWebhookDeliveriesService interface {
+	GetWebhookDeliveries(ctx context.Context, ownerID int64, limit int32) ([]model.WebhookDelivery, error)
+}
+
+func (s *CommentsController) RegisterWebhook(ctx context.Context, in *servicepb.RegisterWebhookRequest) (*servicepb.RegisterWebhookResponse, error) {
+	webhookID, err := s.registerWebhookService.RegisterWebhook(ctx, model.Webhook{
+		OwnerID: in.OwnerID,
+		URL:     in.Url,
+		Secret:  in.Secret,
+	})
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		if errors.Is(err, model.ErrInvalidWebhookURL) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	return &servicepb.RegisterWebhookResponse{
+		WebhookID: webhookID,
+	}, nil
+}
+
+func (s *CommentsController) ListWebhookDeliveries(ctx context.Context, in *servicepb.ListWebhookDeliveriesRequest) (*servicepb.ListWebhookDeliveriesResponse, error) {
+	deliveries, err := s.getWebhookDeliveriesService.GetWebhookDeliveries(ctx, in.OwnerID, in.Limit)
+	if err != nil {
+		logger.Warnw(ctx, "Request failed", "error", err)
+		return nil, status.Error(codes.Internal, "Internal error")
+	}
+	deliveriesResponse := make([]*servicepb.WebhookDelivery, len(deliveries))
+	for i, val := range deliveries {
+		deliveriesResponse[i] = &servicepb.WebhookDelivery{
+			ID:             val.ID,
+			WebhookID:      val.WebhookID,
+			Url:            val.URL,
+			NotificationID: val.NotificationID,
+			Attempt:        val.Attempt,
+			StatusCode:     val.StatusCode,
+			Error:          val.Error,
+			Success:        val.Success,
+			Ts:             timestamppb.New(val.Ts),
+		}
+	}
+	return &servicepb.ListWebhookDeliveriesResponse{
+		OwnerID:    in.OwnerID,
+		Deliveries: deliveriesResponse,
+	}, nil
+}
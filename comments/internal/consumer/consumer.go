@@ -0,0 +1,270 @@
+// Package consumer runs Kafka consumer groups with bounded retries: a message is retried with
+// backoff while it fails with transient errors, and is skipped, or moved to the dead letter topic,
+// once it fails permanently or runs out of attempts, so one message never blocks its partition.
+package consumer
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/app/config"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
+	"example/comments/internal/outbox"
+	"example/comments/internal/trace"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// Headers added to dead letters
+const (
+	HeaderDeadLetterError     = "dead_letter_error"
+	HeaderDeadLetterTopic     = "dead_letter_topic"
+	HeaderDeadLetterPartition = "dead_letter_partition"
+	HeaderDeadLetterOffset    = "dead_letter_offset"
+	HeaderDeadLetterGroup     = "dead_letter_group"
+)
+
+type RetryConfig struct {
+	// Attempts bounds the handling of a message failing with transient errors
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+type Config struct {
+	GroupID string
+	Topics  []string
+	Retry   RetryConfig
+	// DeadLetterTopic receives the messages that failed, they are only logged and skipped if it is empty
+	DeadLetterTopic string
+}
+
+func NewConfig(groupID string, topics []string, conf config.ConsumerConf) Config {
+	return Config{
+		GroupID: groupID,
+		Topics:  topics,
+		Retry: RetryConfig{
+			Attempts:       conf.Attempts,
+			InitialBackoff: time.Duration(conf.InitialBackoff) * time.Millisecond,
+			MaxBackoff:     time.Duration(conf.MaxBackoff) * time.Millisecond,
+		},
+		DeadLetterTopic: conf.DeadLetterTopic,
+	}
+}
+
+// Handler handles a message under a consumer span continuing the trace of the message. An error
+// wrapped with Permanent is not retried.
+type Handler func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent marks err as one that fails the same way on every attempt.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return permanentError{err: err}
+}
+
+func IsPermanent(err error) bool {
+	return errors.As(err, &permanentError{})
+}
+
+// Group consumes the topics of the config with one handler.
+type Group struct {
+	conf        Config
+	group       sarama.ConsumerGroup
+	handler     Handler
+	deadLetters sarama.SyncProducer
+}
+
+var _ sarama.ConsumerGroupHandler = (*Group)(nil)
+
+// New creates the consumer group, with a dead letter topic it creates the producer of dead letters too.
+func New(kafkaConf notification.KafkaConfig, conf Config, handler Handler) (*Group, error) {
+	saramaConf, err := notification.NewSaramaConfig(kafkaConf)
+	if err != nil {
+		return nil, err
+	}
+	saramaConf.Consumer.Offsets.Initial = sarama.OffsetOldest
+	group, err := sarama.NewConsumerGroup(kafkaConf.Brokers, conf.GroupID, saramaConf)
+	if err != nil {
+		return nil, fmt.Errorf("NewConsumerGroup failed: %w", err)
+	}
+	g := &Group{
+		conf:    conf,
+		group:   group,
+		handler: handler,
+	}
+	if conf.DeadLetterTopic != "" {
+		g.deadLetters, err = notification.NewSyncProducer(notification.ProducerConfig{KafkaConfig: kafkaConf, Idempotent: true})
+		if err != nil {
+			_ = group.Close()
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Start consumes in the background until ctx is canceled.
+func Start(ctx context.Context, kafkaConf notification.KafkaConfig, conf Config, handler Handler) error {
+	g, err := New(kafkaConf, conf, handler)
+	if err != nil {
+		return err
+	}
+	go g.Run(ctx)
+	return nil
+}
+
+// Run consumes until ctx is canceled and closes the group.
+func (g *Group) Run(ctx context.Context) {
+	logger.Infow(ctx, "consumer group consuming", "topics", g.conf.Topics, "group", g.conf.GroupID)
+	defer g.close(ctx)
+	for {
+		err := g.group.Consume(ctx, g.conf.Topics, g)
+		if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+			return
+		}
+		if err != nil {
+			logger.Warnw(ctx, "consume session failed", "error", err.Error(), "group", g.conf.GroupID)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (g *Group) close(ctx context.Context) {
+	if err := g.group.Close(); err != nil {
+		logger.Warnw(ctx, "can not close consumer group", "error", err.Error(), "group", g.conf.GroupID)
+	}
+	if g.deadLetters != nil {
+		if err := g.deadLetters.Close(); err != nil {
+			logger.Warnw(ctx, "can not close dead letter producer", "error", err.Error(), "group", g.conf.GroupID)
+		}
+	}
+}
+
+func (g *Group) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (g *Group) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+// ConsumeClaim marks a message only after it was handled or given up on, which gives
+// at-least-once semantics.
+func (g *Group) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if err := g.handle(session.Context(), msg); err != nil {
+				return err
+			}
+			session.MarkMessage(msg, "")
+		case <-session.Context().Done():
+			return nil
+		}
+	}
+}
+
+// handle retries the message with backoff until it is handled, fails permanently or runs out of
+// attempts. An error is returned only if the session is closed or the dead letter is not sent.
+func (g *Group) handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	ctx, span := startSpan(ctx, msg)
+	defer span.End()
+
+	attempts := max(g.conf.Retry.Attempts, 1)
+	backoff := g.conf.Retry.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := g.handler(ctx, msg)
+		if err == nil {
+			return nil
+		}
+		span.RecordError(err)
+		if IsPermanent(err) {
+			logger.Warnw(ctx, "message failed permanently", "error", err.Error(),
+				"topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+			metrics.IncConsumerFailures(g.conf.GroupID, metrics.ConsumerFailurePermanent)
+			return g.giveUp(ctx, msg, err)
+		}
+		if attempt >= attempts {
+			logger.Warnw(ctx, "message failed all attempts", "error", err.Error(), "attempts", attempt,
+				"topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset)
+			metrics.IncConsumerFailures(g.conf.GroupID, metrics.ConsumerFailureExhausted)
+			return g.giveUp(ctx, msg, err)
+		}
+		logger.Warnw(ctx, "message handling failed", "error", err.Error(),
+			"topic", msg.Topic, "partition", msg.Partition, "offset", msg.Offset, "retry_in", backoff.String())
+		select {
+		case <-ctx.Done():
+			span.SetStatus(codes.Error, "message handling interrupted")
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, g.conf.Retry.MaxBackoff)
+	}
+}
+
+// giveUp moves the message to the dead letter topic, without the topic the message is skipped.
+func (g *Group) giveUp(ctx context.Context, msg *sarama.ConsumerMessage, cause error) error {
+	if g.deadLetters == nil {
+		return nil
+	}
+	headers := make(map[string]string, len(msg.Headers)+5)
+	for _, header := range msg.Headers {
+		headers[string(header.Key)] = string(header.Value)
+	}
+	headers[HeaderDeadLetterError] = cause.Error()
+	headers[HeaderDeadLetterTopic] = msg.Topic
+	headers[HeaderDeadLetterPartition] = strconv.Itoa(int(msg.Partition))
+	headers[HeaderDeadLetterOffset] = strconv.FormatInt(msg.Offset, 10)
+	headers[HeaderDeadLetterGroup] = g.conf.GroupID
+	_, err := outbox.Send(ctx, g.deadLetters, g.conf.DeadLetterTopic, string(msg.Key), msg.Value, headers)
+	if err != nil {
+		return fmt.Errorf("send dead letter failed: %w", err)
+	}
+	metrics.IncConsumerDeadLetters(g.conf.GroupID)
+	return nil
+}
+
+// startSpan starts the consumer span of msg as a child of the span injected by the producer.
+func startSpan(ctx context.Context, msg *sarama.ConsumerMessage) (context.Context, oteltrace.Span) {
+	carrier := propagation.MapCarrier{}
+	for _, header := range msg.Headers {
+		carrier.Set(string(header.Key), string(header.Value))
+	}
+	return trace.Tracer().Start(
+		trace.Propagator().Extract(ctx, carrier),
+		msg.Topic+" process",
+		oteltrace.WithSpanKind(oteltrace.SpanKindConsumer),
+		oteltrace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypeDeliver,
+			semconv.MessagingDestinationName(msg.Topic),
+			semconv.MessagingKafkaMessageOffset(int(msg.Offset)),
+		),
+	)
+}
@@ -0,0 +1,101 @@
+package consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+var retry = RetryConfig{Attempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+func message() *sarama.ConsumerMessage {
+	return &sarama.ConsumerMessage{
+		Topic:     "comments.create-comment",
+		Partition: 1,
+		Offset:    42,
+		Key:       []byte("173"),
+		Value:     []byte(`{"id":1}`),
+		Headers:   []*sarama.RecordHeader{{Key: []byte("event_type"), Value: []byte("comment.created")}},
+	}
+}
+
+func TestHandleRetriesTransientErrors(t *testing.T) {
+	calls := 0
+	g := &Group{conf: Config{GroupID: "test", Retry: retry}, handler: func(_ context.Context, _ *sarama.ConsumerMessage) error {
+		calls++
+		if calls < 3 {
+			return errors.New("database is not available")
+		}
+		return nil
+	}}
+	require.NoError(t, g.handle(context.Background(), message()), "Handle failed")
+	require.Equal(t, 3, calls, "Attempts mismatch")
+}
+
+func TestHandleSkipsAfterAttempts(t *testing.T) {
+	calls := 0
+	g := &Group{conf: Config{GroupID: "test", Retry: retry}, handler: func(_ context.Context, _ *sarama.ConsumerMessage) error {
+		calls++
+		return errors.New("database is not available")
+	}}
+	require.NoError(t, g.handle(context.Background(), message()), "Exhausted message is not skipped")
+	require.Equal(t, 3, calls, "Attempts mismatch")
+}
+
+func TestHandleSkipsPermanentErrors(t *testing.T) {
+	calls := 0
+	g := &Group{conf: Config{GroupID: "test", Retry: retry}, handler: func(_ context.Context, _ *sarama.ConsumerMessage) error {
+		calls++
+		return fmt.Errorf("deliver failed: %w", Permanent(errors.New("recipient 173 has no email address")))
+	}}
+	require.NoError(t, g.handle(context.Background(), message()), "Permanent failure is not skipped")
+	require.Equal(t, 1, calls, "Permanent failure is retried")
+}
+
+func TestHandleSendsDeadLetters(t *testing.T) {
+	prod := mocks.NewSyncProducer(t, nil)
+	prod.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		require.Equal(t, "comments.create-comment.dlq", msg.Topic, "Dead letter topic mismatch")
+		key, err := msg.Key.Encode()
+		require.NoError(t, err)
+		require.Equal(t, "173", string(key), "Key mismatch")
+		headers := map[string]string{}
+		for _, header := range msg.Headers {
+			headers[string(header.Key)] = string(header.Value)
+		}
+		require.Equal(t, "comment.created", headers["event_type"], "Original header is lost")
+		require.Equal(t, "unknown notification channel: sms", headers[HeaderDeadLetterError], "Error header mismatch")
+		require.Equal(t, "comments.create-comment", headers[HeaderDeadLetterTopic], "Topic header mismatch")
+		require.Equal(t, "1", headers[HeaderDeadLetterPartition], "Partition header mismatch")
+		require.Equal(t, "42", headers[HeaderDeadLetterOffset], "Offset header mismatch")
+		require.Equal(t, "test", headers[HeaderDeadLetterGroup], "Group header mismatch")
+		return nil
+	})
+	prod.ExpectSendMessageAndFail(errors.New("broker is not available"))
+	g := &Group{
+		conf:        Config{GroupID: "test", Retry: retry, DeadLetterTopic: "comments.create-comment.dlq"},
+		deadLetters: prod,
+		handler: func(_ context.Context, _ *sarama.ConsumerMessage) error {
+			return Permanent(errors.New("unknown notification channel: sms"))
+		},
+	}
+	require.NoError(t, g.handle(context.Background(), message()), "Dead letter is not sent")
+	require.Error(t, g.handle(context.Background(), message()), "Message is skipped without dead letter")
+	require.NoError(t, prod.Close())
+}
+
+func TestHandleStopsOnClosedSession(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	g := &Group{conf: Config{GroupID: "test", Retry: RetryConfig{Attempts: 3, InitialBackoff: time.Hour}},
+		handler: func(_ context.Context, _ *sarama.ConsumerMessage) error {
+			cancel()
+			return errors.New("database is not available")
+		}}
+	require.ErrorIs(t, g.handle(ctx, message()), context.Canceled, "Closed session error mismatch")
+}
@@ -0,0 +1,38 @@
+package events
+
+import (
+	"context"
+	"example/comments/internal/app/config"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// Handler applies an event of a topic. Malformed events fail permanently, other errors are
+// retried with backoff by the consumer.
+type Handler interface {
+	Handle(ctx context.Context, msg *sarama.ConsumerMessage) error
+}
+
+// StartConsumer consumes the topics of handlers in one consumer group until ctx is canceled.
+func StartConsumer(ctx context.Context, kafkaConf notification.KafkaConfig, groupID string,
+	conf config.ConsumerConf, handlers map[string]Handler) error {
+	topics := make([]string, 0, len(handlers))
+	for topic := range handlers {
+		topics = append(topics, topic)
+	}
+	return consumer.Start(ctx, kafkaConf, consumer.NewConfig(groupID, topics, conf), dispatch(handlers))
+}
+
+// dispatch picks the handler by the topic of the message.
+func dispatch(handlers map[string]Handler) consumer.Handler {
+	return func(ctx context.Context, msg *sarama.ConsumerMessage) error {
+		handler, ok := handlers[msg.Topic]
+		if !ok {
+			return consumer.Permanent(fmt.Errorf("no handler of topic %s", msg.Topic))
+		}
+		return handler.Handle(ctx, msg)
+	}
+}
@@ -0,0 +1,32 @@
+package events
+
+import (
+	"context"
+	"example/comments/internal/consumer"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+)
+
+type handlerFunc func(ctx context.Context, msg *sarama.ConsumerMessage) error
+
+func (f handlerFunc) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	return f(ctx, msg)
+}
+
+func TestDispatch(t *testing.T) {
+	topics := []string{}
+	record := handlerFunc(func(_ context.Context, msg *sarama.ConsumerMessage) error {
+		topics = append(topics, msg.Topic)
+		return nil
+	})
+	handle := dispatch(map[string]Handler{"products.events": record, "users.events": record})
+
+	require.NoError(t, handle(context.Background(), &sarama.ConsumerMessage{Topic: "users.events"}), "Dispatch failed")
+	require.NoError(t, handle(context.Background(), &sarama.ConsumerMessage{Topic: "products.events"}), "Dispatch failed")
+	require.Equal(t, []string{"users.events", "products.events"}, topics, "Topics mismatch")
+
+	err := handle(context.Background(), &sarama.ConsumerMessage{Topic: "orders.events"})
+	require.True(t, consumer.IsPermanent(err), "Error of an unknown topic is not permanent")
+}
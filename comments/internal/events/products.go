@@ -0,0 +1,96 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example/comments/internal/consumer"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// ProductsConsumer names the products events consumer in the inbox.
+const ProductsConsumer = "comments-product-events"
+
+type ProductEventsRepository interface {
+	SetProductDeleted(_ context.Context, consumer string, eventID string, productID int64, deleted bool) (bool, int64, error)
+	ChangeProductOwner(_ context.Context, consumer string, eventID string, productID int64, ownerID int64) (bool, model.OwnerChangeResult, error)
+}
+
+// ProductCache drops the cached state of a changed product.
+type ProductCache interface {
+	InvalidateProduct(productID int64)
+}
+
+// ProductsHandler hides the comments of deleted products and shows them again on restore,
+// on ownership change it moves the pending owner notifications to the new owner.
+type ProductsHandler struct {
+	rep   ProductEventsRepository
+	cache ProductCache
+}
+
+func NewProductsHandler(rep ProductEventsRepository, cache ProductCache) *ProductsHandler {
+	return &ProductsHandler{
+		rep:   rep,
+		cache: cache,
+	}
+}
+
+func (h *ProductsHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	event := model.ProductEvent{}
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return consumer.Permanent(fmt.Errorf("malformed product event: %w", err))
+	}
+	if event.EventID == "" || event.ProductID <= 0 {
+		return consumer.Permanent(errors.New("malformed product event: no event or product id"))
+	}
+	h.cache.InvalidateProduct(event.ProductID)
+	var deleted bool
+	switch event.EventType {
+	case model.EventProductDeleted:
+		deleted = true
+	case model.EventProductRestored:
+		deleted = false
+	case model.EventProductOwnerChanged:
+		return h.changeOwner(ctx, event)
+	default:
+		return nil
+	}
+	processed, changed, err := h.rep.SetProductDeleted(ctx, ProductsConsumer, event.EventID, event.ProductID, deleted)
+	if err != nil {
+		return err
+	}
+	logger.Infow(ctx, "product event applied",
+		"event_id", event.EventID,
+		"event_type", event.EventType,
+		"product_id", event.ProductID,
+		"duplicate", !processed,
+		"comments", changed)
+	return nil
+}
+
+func (h *ProductsHandler) changeOwner(ctx context.Context, event model.ProductEvent) error {
+	if event.NewOwnerID <= 0 {
+		logger.Warnw(ctx, "skip product event without new owner", "event_id", event.EventID)
+		return nil
+	}
+	processed, res, err := h.rep.ChangeProductOwner(ctx, ProductsConsumer, event.EventID, event.ProductID, event.NewOwnerID)
+	if err != nil {
+		return err
+	}
+	logger.Infow(ctx, "product event applied",
+		"event_id", event.EventID,
+		"event_type", event.EventType,
+		"product_id", event.ProductID,
+		"old_owner_id", event.OldOwnerID,
+		"new_owner_id", event.NewOwnerID,
+		"duplicate", !processed,
+		"reassigned", res.Reassigned,
+		"canceled", res.Canceled,
+		"reasons", res.Reasons,
+		"webhook_tasks", res.WebhookTasks)
+	return nil
+}
@@ -0,0 +1,138 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example/comments/internal/consumer"
+	"example/comments/internal/model"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+)
+
+// inbox remembers the handled events like the inbox table does.
+type inbox map[string]bool
+
+func (i inbox) process(consumer string, eventID string) bool {
+	key := consumer + "/" + eventID
+	if i[key] {
+		return false
+	}
+	i[key] = true
+	return true
+}
+
+type productsRepStub struct {
+	inbox   inbox
+	err     error
+	deleted map[int64]bool
+	owners  map[int64]int64
+	applied int
+}
+
+func newProductsRepStub() *productsRepStub {
+	return &productsRepStub{inbox: inbox{}, deleted: map[int64]bool{}, owners: map[int64]int64{}}
+}
+
+func (r *productsRepStub) SetProductDeleted(_ context.Context, consumer string, eventID string, productID int64, deleted bool) (bool, int64, error) {
+	if r.err != nil {
+		return false, 0, r.err
+	}
+	if !r.inbox.process(consumer, eventID) {
+		return false, 0, nil
+	}
+	r.applied++
+	r.deleted[productID] = deleted
+	return true, 1, nil
+}
+
+func (r *productsRepStub) ChangeProductOwner(_ context.Context, consumer string, eventID string, productID int64, ownerID int64) (bool, model.OwnerChangeResult, error) {
+	if r.err != nil {
+		return false, model.OwnerChangeResult{}, r.err
+	}
+	if !r.inbox.process(consumer, eventID) {
+		return false, model.OwnerChangeResult{}, nil
+	}
+	r.applied++
+	r.owners[productID] = ownerID
+	return true, model.OwnerChangeResult{Reassigned: 1}, nil
+}
+
+type productCacheStub struct {
+	invalidated []int64
+}
+
+func (c *productCacheStub) InvalidateProduct(productID int64) {
+	c.invalidated = append(c.invalidated, productID)
+}
+
+func productMessage(t *testing.T, event model.ProductEvent) *sarama.ConsumerMessage {
+	value, err := json.Marshal(event)
+	require.NoError(t, err, "Marshal failed")
+	return &sarama.ConsumerMessage{Topic: "products.events", Value: value}
+}
+
+func TestProductsHandlerDeleteAndRestore(t *testing.T) {
+	rep := newProductsRepStub()
+	cache := &productCacheStub{}
+	handler := NewProductsHandler(rep, cache)
+	ctx := context.Background()
+
+	deleted := productMessage(t, model.ProductEvent{EventID: "1", EventType: model.EventProductDeleted, ProductID: 2})
+	require.NoError(t, handler.Handle(ctx, deleted), "Handle of delete failed")
+	require.True(t, rep.deleted[2], "Product is not deleted")
+
+	restored := productMessage(t, model.ProductEvent{EventID: "2", EventType: model.EventProductRestored, ProductID: 2})
+	require.NoError(t, handler.Handle(ctx, restored), "Handle of restore failed")
+	require.False(t, rep.deleted[2], "Product is not restored")
+	require.Equal(t, []int64{2, 2}, cache.invalidated, "Invalidated products mismatch")
+}
+
+func TestProductsHandlerDuplicate(t *testing.T) {
+	rep := newProductsRepStub()
+	handler := NewProductsHandler(rep, &productCacheStub{})
+	ctx := context.Background()
+
+	deleted := productMessage(t, model.ProductEvent{EventID: "1", EventType: model.EventProductDeleted, ProductID: 2})
+	restored := productMessage(t, model.ProductEvent{EventID: "2", EventType: model.EventProductRestored, ProductID: 2})
+	require.NoError(t, handler.Handle(ctx, deleted), "Handle of delete failed")
+	require.NoError(t, handler.Handle(ctx, restored), "Handle of restore failed")
+	require.NoError(t, handler.Handle(ctx, deleted), "Handle of redelivered delete failed")
+	require.False(t, rep.deleted[2], "Redelivered delete is applied")
+	require.Equal(t, 2, rep.applied, "Applied events mismatch")
+}
+
+func TestProductsHandlerOwnerChange(t *testing.T) {
+	rep := newProductsRepStub()
+	handler := NewProductsHandler(rep, &productCacheStub{})
+	ctx := context.Background()
+
+	changed := productMessage(t, model.ProductEvent{EventID: "1", EventType: model.EventProductOwnerChanged,
+		ProductID: 2, OldOwnerID: 7, NewOwnerID: 8})
+	require.NoError(t, handler.Handle(ctx, changed), "Handle of owner change failed")
+	require.NoError(t, handler.Handle(ctx, changed), "Handle of redelivered owner change failed")
+	require.Equal(t, int64(8), rep.owners[2], "Owner mismatch")
+	require.Equal(t, 1, rep.applied, "Applied events mismatch")
+
+	noOwner := productMessage(t, model.ProductEvent{EventID: "2", EventType: model.EventProductOwnerChanged, ProductID: 3})
+	require.NoError(t, handler.Handle(ctx, noOwner), "Handle of owner change without owner failed")
+	require.NotContains(t, rep.owners, int64(3), "Owner change without owner is applied")
+}
+
+func TestProductsHandlerErrors(t *testing.T) {
+	rep := newProductsRepStub()
+	handler := NewProductsHandler(rep, &productCacheStub{})
+	ctx := context.Background()
+
+	for _, value := range []string{`{`, `{"event_type":"product.deleted","product_id":2}`, `{"event_id":"1","event_type":"product.deleted"}`} {
+		err := handler.Handle(ctx, &sarama.ConsumerMessage{Value: []byte(value)})
+		require.True(t, consumer.IsPermanent(err), "Error of %s is not permanent", value)
+	}
+
+	rep.err = errors.New("connection refused")
+	err := handler.Handle(ctx, productMessage(t, model.ProductEvent{EventID: "1", EventType: model.EventProductDeleted, ProductID: 2}))
+	require.Error(t, err, "Repository error is lost")
+	require.False(t, consumer.IsPermanent(err), "Repository error is permanent")
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example/comments/internal/consumer"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// UsersConsumer names the users events consumer in the inbox.
+const UsersConsumer = "comments-user-events"
+
+type UserEventsRepository interface {
+	SetUserBanned(_ context.Context, consumer string, eventID string, userID int64, banned bool) (bool, model.BanResult, error)
+}
+
+// UserCache drops the cached state of a changed user.
+type UserCache interface {
+	InvalidateUser(userID int64)
+}
+
+// UsersHandler hides the comments of banned users and shows them again on unban.
+type UsersHandler struct {
+	rep   UserEventsRepository
+	cache UserCache
+}
+
+func NewUsersHandler(rep UserEventsRepository, cache UserCache) *UsersHandler {
+	return &UsersHandler{
+		rep:   rep,
+		cache: cache,
+	}
+}
+
+func (h *UsersHandler) Handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	event := model.UserEvent{}
+	if err := json.Unmarshal(msg.Value, &event); err != nil {
+		return consumer.Permanent(fmt.Errorf("malformed user event: %w", err))
+	}
+	if event.EventID == "" || event.UserID <= 0 {
+		return consumer.Permanent(errors.New("malformed user event: no event or user id"))
+	}
+	h.cache.InvalidateUser(event.UserID)
+	var banned bool
+	switch event.EventType {
+	case model.EventUserBanned:
+		banned = true
+	case model.EventUserUnbanned:
+		banned = false
+	default:
+		return nil
+	}
+	processed, res, err := h.rep.SetUserBanned(ctx, UsersConsumer, event.EventID, event.UserID, banned)
+	if err != nil {
+		return err
+	}
+	logger.Infow(ctx, "user event applied",
+		"event_id", event.EventID,
+		"event_type", event.EventType,
+		"user_id", event.UserID,
+		"duplicate", !processed,
+		"comments", res.Comments,
+		"canceled_notifications", res.Notifications,
+		"canceled_webhook_tasks", res.WebhookTasks,
+		"deleted_messages", res.Messages)
+	return nil
+}
@@ -0,0 +1,61 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/consumer"
+	"example/comments/internal/model"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+)
+
+type usersRepStub struct {
+	inbox   inbox
+	banned  map[int64]bool
+	applied int
+}
+
+func (r *usersRepStub) SetUserBanned(_ context.Context, consumer string, eventID string, userID int64, banned bool) (bool, model.BanResult, error) {
+	if !r.inbox.process(consumer, eventID) {
+		return false, model.BanResult{}, nil
+	}
+	r.applied++
+	r.banned[userID] = banned
+	return true, model.BanResult{Comments: 1}, nil
+}
+
+type userCacheStub struct {
+	invalidated []int64
+}
+
+func (c *userCacheStub) InvalidateUser(userID int64) {
+	c.invalidated = append(c.invalidated, userID)
+}
+
+func userMessage(t *testing.T, event model.UserEvent) *sarama.ConsumerMessage {
+	value, err := json.Marshal(event)
+	require.NoError(t, err, "Marshal failed")
+	return &sarama.ConsumerMessage{Topic: "users.events", Value: value}
+}
+
+func TestUsersHandler(t *testing.T) {
+	rep := &usersRepStub{inbox: inbox{}, banned: map[int64]bool{}}
+	cache := &userCacheStub{}
+	handler := NewUsersHandler(rep, cache)
+	ctx := context.Background()
+
+	banned := userMessage(t, model.UserEvent{EventID: "1", EventType: model.EventUserBanned, UserID: 3})
+	unbanned := userMessage(t, model.UserEvent{EventID: "2", EventType: model.EventUserUnbanned, UserID: 3})
+	require.NoError(t, handler.Handle(ctx, banned), "Handle of ban failed")
+	require.True(t, rep.banned[3], "User is not banned")
+	require.NoError(t, handler.Handle(ctx, unbanned), "Handle of unban failed")
+	require.NoError(t, handler.Handle(ctx, banned), "Handle of redelivered ban failed")
+	require.False(t, rep.banned[3], "Redelivered ban is applied")
+	require.Equal(t, 2, rep.applied, "Applied events mismatch")
+	require.Equal(t, []int64{3, 3, 3}, cache.invalidated, "Invalidated users mismatch")
+
+	err := handler.Handle(ctx, &sarama.ConsumerMessage{Value: []byte(`{"event_id":"3","event_type":"user.banned"}`)})
+	require.True(t, consumer.IsPermanent(err), "Error of an event without user is not permanent")
+}
@@ -252,53 +252,4553 @@ var _ interface {
 	ErrorName() string
 } = CheckUserIDResponseValidationError{}
 
+// Validate checks the field values on CheckUserIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckUserIDsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckUserIDsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckUserIDsRequestMultiError, or nil if none found.
+func (m *CheckUserIDsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckUserIDsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIDs()); l < 1 || l > 1000 {
+		err := CheckUserIDsRequestValidationError{
+			field:  "UserIDs",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIDs() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := CheckUserIDsRequestValidationError{
+				field:  fmt.Sprintf("UserIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckUserIDsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckUserIDsRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckUserIDsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckUserIDsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckUserIDsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckUserIDsRequestMultiError) AllErrors() []error { return m }
+
+// CheckUserIDsRequestValidationError is the validation error returned by
+// CheckUserIDsRequest.Validate if the designated constraints aren't met.
+type CheckUserIDsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckUserIDsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckUserIDsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckUserIDsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckUserIDsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckUserIDsRequestValidationError) ErrorName() string {
+	return "CheckUserIDsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckUserIDsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckUserIDsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckUserIDsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckUserIDsRequestValidationError{}
+
+// Validate checks the field values on CheckUserIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CheckUserIDsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckUserIDsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckUserIDsResponseMultiError, or nil if none found.
+func (m *CheckUserIDsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckUserIDsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsCorrect
+
+	if len(errors) > 0 {
+		return CheckUserIDsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckUserIDsResponseMultiError is an error wrapping multiple validation
+// errors returned by CheckUserIDsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CheckUserIDsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckUserIDsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckUserIDsResponseMultiError) AllErrors() []error { return m }
+
+// CheckUserIDsResponseValidationError is the validation error returned by
+// CheckUserIDsResponse.Validate if the designated constraints aren't met.
+type CheckUserIDsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckUserIDsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckUserIDsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckUserIDsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckUserIDsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckUserIDsResponseValidationError) ErrorName() string {
+	return "CheckUserIDsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckUserIDsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckUserIDsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckUserIDsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckUserIDsResponseValidationError{}
+
+// Validate checks the field values on GetUserProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserProfilesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserProfilesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserProfilesRequestMultiError, or nil if none found.
+func (m *GetUserProfilesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserProfilesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetUserIDs()); l < 1 || l > 1000 {
+		err := GetUserProfilesRequestValidationError{
+			field:  "UserIDs",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetUserIDs() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := GetUserProfilesRequestValidationError{
+				field:  fmt.Sprintf("UserIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetUserProfilesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserProfilesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetUserProfilesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserProfilesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserProfilesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserProfilesRequestMultiError) AllErrors() []error { return m }
+
+// GetUserProfilesRequestValidationError is the validation error returned by
+// GetUserProfilesRequest.Validate if the designated constraints aren't met.
+type GetUserProfilesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserProfilesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserProfilesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserProfilesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserProfilesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserProfilesRequestValidationError) ErrorName() string {
+	return "GetUserProfilesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserProfilesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserProfilesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserProfilesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserProfilesRequestValidationError{}
+
+// Validate checks the field values on UserProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserProfile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserProfile with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserProfileMultiError, or
+// nil if none found.
+func (m *UserProfile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserProfile) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AvatarURL
+
+	if len(errors) > 0 {
+		return UserProfileMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserProfileMultiError is an error wrapping multiple validation errors
+// returned by UserProfile.ValidateAll() if the designated constraints aren't met.
+type UserProfileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserProfileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserProfileMultiError) AllErrors() []error { return m }
+
+// UserProfileValidationError is the validation error returned by
+// UserProfile.Validate if the designated constraints aren't met.
+type UserProfileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserProfileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserProfileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserProfileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserProfileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserProfileValidationError) ErrorName() string { return "UserProfileValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserProfileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserProfile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserProfileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserProfileValidationError{}
+
+// Validate checks the field values on GetUserProfilesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetUserProfilesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetUserProfilesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetUserProfilesResponseMultiError, or nil if none found.
+func (m *GetUserProfilesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetUserProfilesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	{
+		sorted_keys := make([]int64, len(m.GetProfiles()))
+		i := 0
+		for key := range m.GetProfiles() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetProfiles()[key]
+			_ = val
+
+			// no validation rules for Profiles[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, GetUserProfilesResponseValidationError{
+							field:  fmt.Sprintf("Profiles[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, GetUserProfilesResponseValidationError{
+							field:  fmt.Sprintf("Profiles[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return GetUserProfilesResponseValidationError{
+						field:  fmt.Sprintf("Profiles[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetUserProfilesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetUserProfilesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetUserProfilesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetUserProfilesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetUserProfilesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetUserProfilesResponseMultiError) AllErrors() []error { return m }
+
+// GetUserProfilesResponseValidationError is the validation error returned by
+// GetUserProfilesResponse.Validate if the designated constraints aren't met.
+type GetUserProfilesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetUserProfilesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetUserProfilesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetUserProfilesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetUserProfilesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetUserProfilesResponseValidationError) ErrorName() string {
+	return "GetUserProfilesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetUserProfilesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetUserProfilesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetUserProfilesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetUserProfilesResponseValidationError{}
+
 // Validate checks the field values on GetOwnerRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetOwnerRequest) Validate() error {
+func (m *GetOwnerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOwnerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOwnerRequestMultiError, or nil if none found.
+func (m *GetOwnerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOwnerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := GetOwnerRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOwnerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOwnerRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOwnerRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOwnerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOwnerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOwnerRequestMultiError) AllErrors() []error { return m }
+
+// GetOwnerRequestValidationError is the validation error returned by
+// GetOwnerRequest.Validate if the designated constraints aren't met.
+type GetOwnerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOwnerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOwnerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOwnerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOwnerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOwnerRequestValidationError) ErrorName() string { return "GetOwnerRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOwnerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOwnerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOwnerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOwnerRequestValidationError{}
+
+// Validate checks the field values on GetOwnerResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOwnerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOwnerResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOwnerResponseMultiError, or nil if none found.
+func (m *GetOwnerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOwnerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerID
+
+	if len(errors) > 0 {
+		return GetOwnerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOwnerResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOwnerResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOwnerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOwnerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOwnerResponseMultiError) AllErrors() []error { return m }
+
+// GetOwnerResponseValidationError is the validation error returned by
+// GetOwnerResponse.Validate if the designated constraints aren't met.
+type GetOwnerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOwnerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOwnerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOwnerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOwnerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOwnerResponseValidationError) ErrorName() string { return "GetOwnerResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOwnerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOwnerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOwnerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOwnerResponseValidationError{}
+
+// Validate checks the field values on GetOwnersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOwnersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOwnersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOwnersRequestMultiError, or nil if none found.
+func (m *GetOwnersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOwnersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetProductIDs()); l < 1 || l > 1000 {
+		err := GetOwnersRequestValidationError{
+			field:  "ProductIDs",
+			reason: "value must contain between 1 and 1000 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetProductIDs() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := GetOwnersRequestValidationError{
+				field:  fmt.Sprintf("ProductIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOwnersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOwnersRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOwnersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOwnersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOwnersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOwnersRequestMultiError) AllErrors() []error { return m }
+
+// GetOwnersRequestValidationError is the validation error returned by
+// GetOwnersRequest.Validate if the designated constraints aren't met.
+type GetOwnersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOwnersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOwnersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOwnersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOwnersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOwnersRequestValidationError) ErrorName() string { return "GetOwnersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOwnersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOwnersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOwnersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOwnersRequestValidationError{}
+
+// Validate checks the field values on GetOwnersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOwnersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOwnersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOwnersResponseMultiError, or nil if none found.
+func (m *GetOwnersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOwnersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Owners
+
+	if len(errors) > 0 {
+		return GetOwnersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOwnersResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOwnersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOwnersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOwnersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOwnersResponseMultiError) AllErrors() []error { return m }
+
+// GetOwnersResponseValidationError is the validation error returned by
+// GetOwnersResponse.Validate if the designated constraints aren't met.
+type GetOwnersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOwnersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOwnersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOwnersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOwnersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOwnersResponseValidationError) ErrorName() string {
+	return "GetOwnersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOwnersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOwnersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOwnersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOwnersResponseValidationError{}
+
+// Validate checks the field values on User with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on User with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in UserMultiError, or nil if none found.
+func (m *User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	// no validation rules for Name
+
+	// no validation rules for Status
+
+	// no validation rules for AvatarURL
+
+	if len(errors) > 0 {
+		return UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserMultiError is an error wrapping multiple validation errors returned by
+// User.ValidateAll() if the designated constraints aren't met.
+type UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserMultiError) AllErrors() []error { return m }
+
+// UserValidationError is the validation error returned by User.Validate if the
+// designated constraints aren't met.
+type UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserValidationError) ErrorName() string { return "UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUser.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserValidationError{}
+
+// Validate checks the field values on Product with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Product) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Product with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ProductMultiError, or nil if none found.
+func (m *Product) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Product) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductID
+
+	// no validation rules for Name
+
+	// no validation rules for OwnerID
+
+	// no validation rules for Status
+
+	if len(errors) > 0 {
+		return ProductMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProductMultiError is an error wrapping multiple validation errors returned
+// by Product.ValidateAll() if the designated constraints aren't met.
+type ProductMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProductMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProductMultiError) AllErrors() []error { return m }
+
+// ProductValidationError is the validation error returned by Product.Validate
+// if the designated constraints aren't met.
+type ProductValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProductValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProductValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProductValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProductValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProductValidationError) ErrorName() string { return "ProductValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProductValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProduct.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProductValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProductValidationError{}
+
+// Validate checks the field values on CreateUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserRequestMultiError, or nil if none found.
+func (m *CreateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := CreateUserRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := CreateUserRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAvatarURL()) > 2048 {
+		err := CreateUserRequestValidationError{
+			field:  "AvatarURL",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserRequestMultiError) AllErrors() []error { return m }
+
+// CreateUserRequestValidationError is the validation error returned by
+// CreateUserRequest.Validate if the designated constraints aren't met.
+type CreateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserRequestValidationError) ErrorName() string {
+	return "CreateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserRequestValidationError{}
+
+// Validate checks the field values on CreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateUserResponseMultiError, or nil if none found.
+func (m *CreateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateUserResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateUserResponseMultiError) AllErrors() []error { return m }
+
+// CreateUserResponseValidationError is the validation error returned by
+// CreateUserResponse.Validate if the designated constraints aren't met.
+type CreateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateUserResponseValidationError) ErrorName() string {
+	return "CreateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateUserResponseValidationError{}
+
+// Validate checks the field values on UpdateUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserRequestMultiError, or nil if none found.
+func (m *UpdateUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := UpdateUserRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := UpdateUserRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAvatarURL()) > 2048 {
+		err := UpdateUserRequestValidationError{
+			field:  "AvatarURL",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserRequestMultiError) AllErrors() []error { return m }
+
+// UpdateUserRequestValidationError is the validation error returned by
+// UpdateUserRequest.Validate if the designated constraints aren't met.
+type UpdateUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserRequestValidationError) ErrorName() string {
+	return "UpdateUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserRequestValidationError{}
+
+// Validate checks the field values on UpdateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateUserResponseMultiError, or nil if none found.
+func (m *UpdateUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateUserResponseMultiError is an error wrapping multiple validation errors
+// returned by UpdateUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateUserResponseMultiError) AllErrors() []error { return m }
+
+// UpdateUserResponseValidationError is the validation error returned by
+// UpdateUserResponse.Validate if the designated constraints aren't met.
+type UpdateUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateUserResponseValidationError) ErrorName() string {
+	return "UpdateUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateUserResponseValidationError{}
+
+// Validate checks the field values on RemoveUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUserRequestMultiError, or nil if none found.
+func (m *RemoveUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := RemoveUserRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUserRequestMultiError is an error wrapping multiple validation errors
+// returned by RemoveUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUserRequestMultiError) AllErrors() []error { return m }
+
+// RemoveUserRequestValidationError is the validation error returned by
+// RemoveUserRequest.Validate if the designated constraints aren't met.
+type RemoveUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUserRequestValidationError) ErrorName() string {
+	return "RemoveUserRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUserRequestValidationError{}
+
+// Validate checks the field values on RemoveUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveUserResponseMultiError, or nil if none found.
+func (m *RemoveUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUser()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveUserResponseValidationError{
+					field:  "User",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUser()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveUserResponseValidationError{
+				field:  "User",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveUserResponseMultiError is an error wrapping multiple validation errors
+// returned by RemoveUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type RemoveUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveUserResponseMultiError) AllErrors() []error { return m }
+
+// RemoveUserResponseValidationError is the validation error returned by
+// RemoveUserResponse.Validate if the designated constraints aren't met.
+type RemoveUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveUserResponseValidationError) ErrorName() string {
+	return "RemoveUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveUserResponseValidationError{}
+
+// Validate checks the field values on CreateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProductRequestMultiError, or nil if none found.
+func (m *CreateProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := CreateProductRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := CreateProductRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOwnerID() <= 0 {
+		err := CreateProductRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProductRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProductRequestMultiError) AllErrors() []error { return m }
+
+// CreateProductRequestValidationError is the validation error returned by
+// CreateProductRequest.Validate if the designated constraints aren't met.
+type CreateProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProductRequestValidationError) ErrorName() string {
+	return "CreateProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProductRequestValidationError{}
+
+// Validate checks the field values on CreateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateProductResponseMultiError, or nil if none found.
+func (m *CreateProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateProductResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateProductResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateProductResponseMultiError) AllErrors() []error { return m }
+
+// CreateProductResponseValidationError is the validation error returned by
+// CreateProductResponse.Validate if the designated constraints aren't met.
+type CreateProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateProductResponseValidationError) ErrorName() string {
+	return "CreateProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateProductResponseValidationError{}
+
+// Validate checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductRequestMultiError, or nil if none found.
+func (m *UpdateProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := UpdateProductRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := UpdateProductRequestValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UpdateProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductRequestMultiError) AllErrors() []error { return m }
+
+// UpdateProductRequestValidationError is the validation error returned by
+// UpdateProductRequest.Validate if the designated constraints aren't met.
+type UpdateProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductRequestValidationError) ErrorName() string {
+	return "UpdateProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductRequestValidationError{}
+
+// Validate checks the field values on UpdateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateProductResponseMultiError, or nil if none found.
+func (m *UpdateProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateProductResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateProductResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateProductResponseMultiError) AllErrors() []error { return m }
+
+// UpdateProductResponseValidationError is the validation error returned by
+// UpdateProductResponse.Validate if the designated constraints aren't met.
+type UpdateProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateProductResponseValidationError) ErrorName() string {
+	return "UpdateProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateProductResponseValidationError{}
+
+// Validate checks the field values on RemoveProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProductRequestMultiError, or nil if none found.
+func (m *RemoveProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := RemoveProductRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProductRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProductRequestMultiError) AllErrors() []error { return m }
+
+// RemoveProductRequestValidationError is the validation error returned by
+// RemoveProductRequest.Validate if the designated constraints aren't met.
+type RemoveProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProductRequestValidationError) ErrorName() string {
+	return "RemoveProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProductRequestValidationError{}
+
+// Validate checks the field values on RemoveProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveProductResponseMultiError, or nil if none found.
+func (m *RemoveProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetProduct()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RemoveProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RemoveProductResponseValidationError{
+					field:  "Product",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetProduct()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RemoveProductResponseValidationError{
+				field:  "Product",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RemoveProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveProductResponseMultiError is an error wrapping multiple validation
+// errors returned by RemoveProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveProductResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveProductResponseMultiError) AllErrors() []error { return m }
+
+// RemoveProductResponseValidationError is the validation error returned by
+// RemoveProductResponse.Validate if the designated constraints aren't met.
+type RemoveProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveProductResponseValidationError) ErrorName() string {
+	return "RemoveProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveProductResponseValidationError{}
+
+// Validate checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductRequestMultiError, or nil if none found.
+func (m *DeleteProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := DeleteProductRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductRequestMultiError) AllErrors() []error { return m }
+
+// DeleteProductRequestValidationError is the validation error returned by
+// DeleteProductRequest.Validate if the designated constraints aren't met.
+type DeleteProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductRequestValidationError) ErrorName() string {
+	return "DeleteProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductRequestValidationError{}
+
+// Validate checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteProductResponseMultiError, or nil if none found.
+func (m *DeleteProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return DeleteProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteProductResponseMultiError is an error wrapping multiple validation
+// errors returned by DeleteProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteProductResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteProductResponseMultiError) AllErrors() []error { return m }
+
+// DeleteProductResponseValidationError is the validation error returned by
+// DeleteProductResponse.Validate if the designated constraints aren't met.
+type DeleteProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteProductResponseValidationError) ErrorName() string {
+	return "DeleteProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteProductResponseValidationError{}
+
+// Validate checks the field values on RestoreProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreProductRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreProductRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreProductRequestMultiError, or nil if none found.
+func (m *RestoreProductRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreProductRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := RestoreProductRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RestoreProductRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreProductRequestMultiError is an error wrapping multiple validation
+// errors returned by RestoreProductRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreProductRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreProductRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreProductRequestMultiError) AllErrors() []error { return m }
+
+// RestoreProductRequestValidationError is the validation error returned by
+// RestoreProductRequest.Validate if the designated constraints aren't met.
+type RestoreProductRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreProductRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreProductRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreProductRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreProductRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreProductRequestValidationError) ErrorName() string {
+	return "RestoreProductRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreProductRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreProductRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreProductRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreProductRequestValidationError{}
+
+// Validate checks the field values on RestoreProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RestoreProductResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RestoreProductResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RestoreProductResponseMultiError, or nil if none found.
+func (m *RestoreProductResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RestoreProductResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return RestoreProductResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RestoreProductResponseMultiError is an error wrapping multiple validation
+// errors returned by RestoreProductResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RestoreProductResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RestoreProductResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RestoreProductResponseMultiError) AllErrors() []error { return m }
+
+// RestoreProductResponseValidationError is the validation error returned by
+// RestoreProductResponse.Validate if the designated constraints aren't met.
+type RestoreProductResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RestoreProductResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RestoreProductResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RestoreProductResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RestoreProductResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RestoreProductResponseValidationError) ErrorName() string {
+	return "RestoreProductResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RestoreProductResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRestoreProductResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RestoreProductResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RestoreProductResponseValidationError{}
+
+// Validate checks the field values on ChangeProductOwnerRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeProductOwnerRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeProductOwnerRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeProductOwnerRequestMultiError, or nil if none found.
+func (m *ChangeProductOwnerRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeProductOwnerRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetProductID() <= 0 {
+		err := ChangeProductOwnerRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetOwnerID() <= 0 {
+		err := ChangeProductOwnerRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ChangeProductOwnerRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeProductOwnerRequestMultiError is an error wrapping multiple validation
+// errors returned by ChangeProductOwnerRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ChangeProductOwnerRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeProductOwnerRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeProductOwnerRequestMultiError) AllErrors() []error { return m }
+
+// ChangeProductOwnerRequestValidationError is the validation error returned by
+// ChangeProductOwnerRequest.Validate if the designated constraints aren't met.
+type ChangeProductOwnerRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeProductOwnerRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeProductOwnerRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeProductOwnerRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeProductOwnerRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeProductOwnerRequestValidationError) ErrorName() string {
+	return "ChangeProductOwnerRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeProductOwnerRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeProductOwnerRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeProductOwnerRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeProductOwnerRequestValidationError{}
+
+// Validate checks the field values on ChangeProductOwnerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeProductOwnerResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeProductOwnerResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeProductOwnerResponseMultiError, or nil if none found.
+func (m *ChangeProductOwnerResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeProductOwnerResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	// no validation rules for PreviousOwnerID
+
+	if len(errors) > 0 {
+		return ChangeProductOwnerResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeProductOwnerResponseMultiError is an error wrapping multiple
+// validation errors returned by ChangeProductOwnerResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeProductOwnerResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeProductOwnerResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeProductOwnerResponseMultiError) AllErrors() []error { return m }
+
+// ChangeProductOwnerResponseValidationError is the validation error returned
+// by ChangeProductOwnerResponse.Validate if the designated constraints aren't met.
+type ChangeProductOwnerResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeProductOwnerResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeProductOwnerResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeProductOwnerResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeProductOwnerResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeProductOwnerResponseValidationError) ErrorName() string {
+	return "ChangeProductOwnerResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeProductOwnerResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeProductOwnerResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChangeProductOwnerResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeProductOwnerResponseValidationError{}
+
+// Validate checks the field values on BanUserRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BanUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BanUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BanUserRequestMultiError,
+// or nil if none found.
+func (m *BanUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BanUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := BanUserRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BanUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BanUserRequestMultiError is an error wrapping multiple validation errors
+// returned by BanUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BanUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BanUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BanUserRequestMultiError) AllErrors() []error { return m }
+
+// BanUserRequestValidationError is the validation error returned by
+// BanUserRequest.Validate if the designated constraints aren't met.
+type BanUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BanUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BanUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BanUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BanUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BanUserRequestValidationError) ErrorName() string { return "BanUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BanUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBanUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BanUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BanUserRequestValidationError{}
+
+// Validate checks the field values on BanUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BanUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BanUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BanUserResponseMultiError, or nil if none found.
+func (m *BanUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BanUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return BanUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BanUserResponseMultiError is an error wrapping multiple validation errors
+// returned by BanUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BanUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BanUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BanUserResponseMultiError) AllErrors() []error { return m }
+
+// BanUserResponseValidationError is the validation error returned by
+// BanUserResponse.Validate if the designated constraints aren't met.
+type BanUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BanUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BanUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BanUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BanUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BanUserResponseValidationError) ErrorName() string { return "BanUserResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BanUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBanUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BanUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BanUserResponseValidationError{}
+
+// Validate checks the field values on UnbanUserRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnbanUserRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnbanUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnbanUserRequestMultiError, or nil if none found.
+func (m *UnbanUserRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnbanUserRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := UnbanUserRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnbanUserRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnbanUserRequestMultiError is an error wrapping multiple validation errors
+// returned by UnbanUserRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnbanUserRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnbanUserRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnbanUserRequestMultiError) AllErrors() []error { return m }
+
+// UnbanUserRequestValidationError is the validation error returned by
+// UnbanUserRequest.Validate if the designated constraints aren't met.
+type UnbanUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnbanUserRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnbanUserRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnbanUserRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnbanUserRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnbanUserRequestValidationError) ErrorName() string { return "UnbanUserRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UnbanUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnbanUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnbanUserRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnbanUserRequestValidationError{}
+
+// Validate checks the field values on UnbanUserResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UnbanUserResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnbanUserResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnbanUserResponseMultiError, or nil if none found.
+func (m *UnbanUserResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnbanUserResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Changed
+
+	if len(errors) > 0 {
+		return UnbanUserResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnbanUserResponseMultiError is an error wrapping multiple validation errors
+// returned by UnbanUserResponse.ValidateAll() if the designated constraints
+// aren't met.
+type UnbanUserResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnbanUserResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnbanUserResponseMultiError) AllErrors() []error { return m }
+
+// UnbanUserResponseValidationError is the validation error returned by
+// UnbanUserResponse.Validate if the designated constraints aren't met.
+type UnbanUserResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnbanUserResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnbanUserResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnbanUserResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnbanUserResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnbanUserResponseValidationError) ErrorName() string {
+	return "UnbanUserResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnbanUserResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnbanUserResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnbanUserResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnbanUserResponseValidationError{}
+
+// Validate checks the field values on FaultRule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FaultRule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FaultRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FaultRuleMultiError, or nil
+// if none found.
+func (m *FaultRule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FaultRule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetMethod()) < 1 {
+		err := FaultRuleValidationError{
+			field:  "Method",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _FaultRule_LatencyDistribution_InLookup[m.GetLatencyDistribution()]; !ok {
+		err := FaultRuleValidationError{
+			field:  "LatencyDistribution",
+			reason: "value must be in list [ fixed uniform normal exponential]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLatencyMs() < 0 {
+		err := FaultRuleValidationError{
+			field:  "LatencyMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLatencyJitterMs() < 0 {
+		err := FaultRuleValidationError{
+			field:  "LatencyJitterMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetErrorRate(); val < 0 || val > 1 {
+		err := FaultRuleValidationError{
+			field:  "ErrorRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ErrorCode
+
+	if val := m.GetTimeoutRate(); val < 0 || val > 1 {
+		err := FaultRuleValidationError{
+			field:  "TimeoutRate",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlapUpMs() < 0 {
+		err := FaultRuleValidationError{
+			field:  "FlapUpMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetFlapDownMs() < 0 {
+		err := FaultRuleValidationError{
+			field:  "FlapDownMs",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FaultRuleMultiError(errors)
+	}
+
+	return nil
+}
+
+// FaultRuleMultiError is an error wrapping multiple validation errors returned
+// by FaultRule.ValidateAll() if the designated constraints aren't met.
+type FaultRuleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FaultRuleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FaultRuleMultiError) AllErrors() []error { return m }
+
+// FaultRuleValidationError is the validation error returned by
+// FaultRule.Validate if the designated constraints aren't met.
+type FaultRuleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FaultRuleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FaultRuleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FaultRuleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FaultRuleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FaultRuleValidationError) ErrorName() string { return "FaultRuleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FaultRuleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFaultRule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FaultRuleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FaultRuleValidationError{}
+
+var _FaultRule_LatencyDistribution_InLookup = map[string]struct{}{
+	"":            {},
+	"fixed":       {},
+	"uniform":     {},
+	"normal":      {},
+	"exponential": {},
+}
+
+// Validate checks the field values on SetFaultsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetFaultsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFaultsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFaultsRequestMultiError, or nil if none found.
+func (m *SetFaultsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFaultsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seed
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetFaultsRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetFaultsRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetFaultsRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetFaultsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFaultsRequestMultiError is an error wrapping multiple validation errors
+// returned by SetFaultsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetFaultsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFaultsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFaultsRequestMultiError) AllErrors() []error { return m }
+
+// SetFaultsRequestValidationError is the validation error returned by
+// SetFaultsRequest.Validate if the designated constraints aren't met.
+type SetFaultsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFaultsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFaultsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFaultsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFaultsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFaultsRequestValidationError) ErrorName() string { return "SetFaultsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetFaultsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFaultsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFaultsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFaultsRequestValidationError{}
+
+// Validate checks the field values on SetFaultsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SetFaultsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetFaultsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetFaultsResponseMultiError, or nil if none found.
+func (m *SetFaultsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetFaultsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seed
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetFaultsResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetFaultsResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetFaultsResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetFaultsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetFaultsResponseMultiError is an error wrapping multiple validation errors
+// returned by SetFaultsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetFaultsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetFaultsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetFaultsResponseMultiError) AllErrors() []error { return m }
+
+// SetFaultsResponseValidationError is the validation error returned by
+// SetFaultsResponse.Validate if the designated constraints aren't met.
+type SetFaultsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetFaultsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetFaultsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetFaultsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetFaultsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetFaultsResponseValidationError) ErrorName() string {
+	return "SetFaultsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetFaultsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetFaultsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetFaultsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetFaultsResponseValidationError{}
+
+// Validate checks the field values on GetFaultsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFaultsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOwnerRequest with the rules
+// ValidateAll checks the field values on GetFaultsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOwnerRequestMultiError, or nil if none found.
-func (m *GetOwnerRequest) ValidateAll() error {
+// GetFaultsRequestMultiError, or nil if none found.
+func (m *GetFaultsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOwnerRequest) validate(all bool) error {
+func (m *GetFaultsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetProductID() <= 0 {
-		err := GetOwnerRequestValidationError{
-			field:  "ProductID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+	if len(errors) > 0 {
+		return GetFaultsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetFaultsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetFaultsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetFaultsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetFaultsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetFaultsRequestMultiError) AllErrors() []error { return m }
+
+// GetFaultsRequestValidationError is the validation error returned by
+// GetFaultsRequest.Validate if the designated constraints aren't met.
+type GetFaultsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetFaultsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetFaultsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetFaultsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetFaultsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetFaultsRequestValidationError) ErrorName() string { return "GetFaultsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetFaultsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetFaultsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetFaultsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetFaultsRequestValidationError{}
+
+// Validate checks the field values on GetFaultsResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetFaultsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetFaultsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetFaultsResponseMultiError, or nil if none found.
+func (m *GetFaultsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetFaultsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seed
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetFaultsResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetFaultsResponseValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetFaultsResponseValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return GetOwnerRequestMultiError(errors)
+		return GetFaultsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOwnerRequestMultiError is an error wrapping multiple validation errors
-// returned by GetOwnerRequest.ValidateAll() if the designated constraints
+// GetFaultsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetFaultsResponse.ValidateAll() if the designated constraints
 // aren't met.
-type GetOwnerRequestMultiError []error
+type GetFaultsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOwnerRequestMultiError) Error() string {
+func (m GetFaultsResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -307,11 +4807,11 @@ func (m GetOwnerRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOwnerRequestMultiError) AllErrors() []error { return m }
+func (m GetFaultsResponseMultiError) AllErrors() []error { return m }
 
-// GetOwnerRequestValidationError is the validation error returned by
-// GetOwnerRequest.Validate if the designated constraints aren't met.
-type GetOwnerRequestValidationError struct {
+// GetFaultsResponseValidationError is the validation error returned by
+// GetFaultsResponse.Validate if the designated constraints aren't met.
+type GetFaultsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -319,22 +4819,24 @@ type GetOwnerRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOwnerRequestValidationError) Field() string { return e.field }
+func (e GetFaultsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOwnerRequestValidationError) Reason() string { return e.reason }
+func (e GetFaultsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOwnerRequestValidationError) Cause() error { return e.cause }
+func (e GetFaultsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOwnerRequestValidationError) Key() bool { return e.key }
+func (e GetFaultsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOwnerRequestValidationError) ErrorName() string { return "GetOwnerRequestValidationError" }
+func (e GetFaultsResponseValidationError) ErrorName() string {
+	return "GetFaultsResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetOwnerRequestValidationError) Error() string {
+func (e GetFaultsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -346,14 +4848,14 @@ func (e GetOwnerRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOwnerRequest.%s: %s%s",
+		"invalid %sGetFaultsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOwnerRequestValidationError{}
+var _ error = GetFaultsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -361,46 +4863,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOwnerRequestValidationError{}
+} = GetFaultsResponseValidationError{}
 
-// Validate checks the field values on GetOwnerResponse with the rules defined
+// Validate checks the field values on SetServingRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *GetOwnerResponse) Validate() error {
+func (m *SetServingRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOwnerResponse with the rules
+// ValidateAll checks the field values on SetServingRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOwnerResponseMultiError, or nil if none found.
-func (m *GetOwnerResponse) ValidateAll() error {
+// SetServingRequestMultiError, or nil if none found.
+func (m *SetServingRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOwnerResponse) validate(all bool) error {
+func (m *SetServingRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OwnerID
+	// no validation rules for Serving
 
 	if len(errors) > 0 {
-		return GetOwnerResponseMultiError(errors)
+		return SetServingRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOwnerResponseMultiError is an error wrapping multiple validation errors
-// returned by GetOwnerResponse.ValidateAll() if the designated constraints
+// SetServingRequestMultiError is an error wrapping multiple validation errors
+// returned by SetServingRequest.ValidateAll() if the designated constraints
 // aren't met.
-type GetOwnerResponseMultiError []error
+type SetServingRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOwnerResponseMultiError) Error() string {
+func (m SetServingRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -409,11 +4911,11 @@ func (m GetOwnerResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOwnerResponseMultiError) AllErrors() []error { return m }
+func (m SetServingRequestMultiError) AllErrors() []error { return m }
 
-// GetOwnerResponseValidationError is the validation error returned by
-// GetOwnerResponse.Validate if the designated constraints aren't met.
-type GetOwnerResponseValidationError struct {
+// SetServingRequestValidationError is the validation error returned by
+// SetServingRequest.Validate if the designated constraints aren't met.
+type SetServingRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -421,22 +4923,24 @@ type GetOwnerResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOwnerResponseValidationError) Field() string { return e.field }
+func (e SetServingRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOwnerResponseValidationError) Reason() string { return e.reason }
+func (e SetServingRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOwnerResponseValidationError) Cause() error { return e.cause }
+func (e SetServingRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOwnerResponseValidationError) Key() bool { return e.key }
+func (e SetServingRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOwnerResponseValidationError) ErrorName() string { return "GetOwnerResponseValidationError" }
+func (e SetServingRequestValidationError) ErrorName() string {
+	return "SetServingRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetOwnerResponseValidationError) Error() string {
+func (e SetServingRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -448,14 +4952,14 @@ func (e GetOwnerResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOwnerResponse.%s: %s%s",
+		"invalid %sSetServingRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOwnerResponseValidationError{}
+var _ error = SetServingRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -463,4 +4967,108 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOwnerResponseValidationError{}
+} = SetServingRequestValidationError{}
+
+// Validate checks the field values on SetServingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetServingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetServingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetServingResponseMultiError, or nil if none found.
+func (m *SetServingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetServingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Serving
+
+	if len(errors) > 0 {
+		return SetServingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetServingResponseMultiError is an error wrapping multiple validation errors
+// returned by SetServingResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SetServingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetServingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetServingResponseMultiError) AllErrors() []error { return m }
+
+// SetServingResponseValidationError is the validation error returned by
+// SetServingResponse.Validate if the designated constraints aren't met.
+type SetServingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetServingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetServingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetServingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetServingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetServingResponseValidationError) ErrorName() string {
+	return "SetServingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetServingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetServingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetServingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetServingResponseValidationError{}
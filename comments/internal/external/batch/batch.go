@@ -0,0 +1,121 @@
+package batch
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// FetchFunc loads the values of the keys in one call, a key missing in the result gets the zero value.
+type FetchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Config sets how long the first lookup waits for others and how many keys go into one call.
+// A zero Window turns the coalescing off.
+type Config struct {
+	Window  time.Duration
+	MaxSize int
+}
+
+// Batcher coalesces concurrent single lookups into batched calls.
+type Batcher[K comparable, V any] struct {
+	fetch   FetchFunc[K, V]
+	window  time.Duration
+	maxSize int
+
+	mu      sync.Mutex
+	pending *call[K, V]
+}
+
+type call[K comparable, V any] struct {
+	ctx        context.Context
+	keys       []K
+	seen       map[K]struct{}
+	deadline   time.Time
+	noDeadline bool
+	timer      *time.Timer
+	done       chan struct{}
+	res        map[K]V
+	err        error
+}
+
+func New[K comparable, V any](fetch FetchFunc[K, V], conf Config) *Batcher[K, V] {
+	maxSize := conf.MaxSize
+	if maxSize <= 0 {
+		maxSize = 1
+	}
+	return &Batcher[K, V]{
+		fetch:   fetch,
+		window:  conf.Window,
+		maxSize: maxSize,
+	}
+}
+
+// Get returns the value of the key, it is loaded together with the keys requested by other
+// callers within the window. The batched call keeps the trace of the first caller and lasts
+// until the latest deadline of the callers.
+func (b *Batcher[K, V]) Get(ctx context.Context, key K) (V, error) {
+	var zero V
+	b.mu.Lock()
+	c := b.pending
+	if c == nil {
+		c = &call[K, V]{
+			ctx:  ctx,
+			seen: make(map[K]struct{}),
+			done: make(chan struct{}),
+		}
+		b.pending = c
+		c.timer = time.AfterFunc(b.window, func() { b.flush(c) })
+	}
+	c.add(ctx, key)
+	if len(c.keys) >= b.maxSize {
+		b.pending = nil
+		c.timer.Stop()
+		go b.run(c)
+	}
+	b.mu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	case <-c.done:
+	}
+	if c.err != nil {
+		return zero, c.err
+	}
+	return c.res[key], nil
+}
+
+func (b *Batcher[K, V]) flush(c *call[K, V]) {
+	b.mu.Lock()
+	if b.pending != c {
+		b.mu.Unlock()
+		return
+	}
+	b.pending = nil
+	b.mu.Unlock()
+	b.run(c)
+}
+
+func (b *Batcher[K, V]) run(c *call[K, V]) {
+	defer close(c.done)
+	ctx := context.WithoutCancel(c.ctx)
+	if !c.noDeadline {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, c.deadline)
+		defer cancel()
+	}
+	c.res, c.err = b.fetch(ctx, c.keys)
+}
+
+func (c *call[K, V]) add(ctx context.Context, key K) {
+	if deadline, ok := ctx.Deadline(); !ok {
+		c.noDeadline = true
+	} else if deadline.After(c.deadline) {
+		c.deadline = deadline
+	}
+	if _, ok := c.seen[key]; ok {
+		return
+	}
+	c.seen[key] = struct{}{}
+	c.keys = append(c.keys, key)
+}
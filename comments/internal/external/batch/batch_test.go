@@ -0,0 +1,61 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcherCoalescesLookups(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls [][]int64
+	)
+	fetch := func(_ context.Context, keys []int64) (map[int64]int64, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, keys)
+		res := make(map[int64]int64, len(keys))
+		for _, key := range keys {
+			res[key] = key * 10
+		}
+		return res, nil
+	}
+	b := New(fetch, Config{Window: 20 * time.Millisecond, MaxSize: 100})
+
+	var wg sync.WaitGroup
+	results := make([]int64, 6)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			val, err := b.Get(context.Background(), int64(i%3+1))
+			require.NoError(t, err, "Get failed")
+			results[i] = val
+		}(i)
+	}
+	wg.Wait()
+
+	require.Equal(t, 1, len(calls), "Fetch calls mismatch")
+	require.ElementsMatch(t, []int64{1, 2, 3}, calls[0], "Batched keys mismatch")
+	for i, val := range results {
+		require.Equal(t, int64(i%3+1)*10, val, "Value mismatch")
+	}
+}
+
+func TestBatcherFlushesFullBatch(t *testing.T) {
+	fetched := make(chan []int64, 2)
+	fetch := func(_ context.Context, keys []int64) (map[int64]bool, error) {
+		fetched <- keys
+		return nil, errors.New("unavailable")
+	}
+	b := New(fetch, Config{Window: time.Hour, MaxSize: 1})
+
+	_, err := b.Get(context.Background(), 7)
+	require.Error(t, err, "Fetch error is lost")
+	require.Equal(t, []int64{7}, <-fetched, "Batched keys mismatch")
+}
@@ -0,0 +1,158 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"example/comments/internal/metrics"
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// LoadFunc loads the value of a key missing in the cache.
+type LoadFunc[K comparable, V any] func(ctx context.Context, key K) (V, error)
+
+// Config sets how long found and not found values are kept and how many entries the cache
+// holds. A zero TTL turns the cache off.
+type Config struct {
+	TTL         time.Duration
+	NegativeTTL time.Duration
+	MaxSize     int
+}
+
+// Cache is a size bounded LRU cache with TTL. Values for which found returns false are kept
+// for NegativeTTL, errors are not cached. Concurrent misses of a key share one load.
+type Cache[K comparable, V any] struct {
+	name  string
+	load  LoadFunc[K, V]
+	found func(V) bool
+	conf  Config
+
+	mu      sync.Mutex
+	entries map[K]*list.Element
+	lru     *list.List
+	group   singleflight.Group
+}
+
+type entry[K comparable, V any] struct {
+	key     K
+	value   V
+	expires time.Time
+	found   bool
+}
+
+func New[K comparable, V any](name string, load LoadFunc[K, V], found func(V) bool, conf Config) *Cache[K, V] {
+	return &Cache[K, V]{
+		name:    name,
+		load:    load,
+		found:   found,
+		conf:    conf,
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func (c *Cache[K, V]) Get(ctx context.Context, key K) (V, error) {
+	if value, found, ok := c.lookup(key); ok {
+		if found {
+			metrics.IncCacheLookups(c.name, metrics.CacheHit)
+		} else {
+			metrics.IncCacheLookups(c.name, metrics.CacheNegativeHit)
+		}
+		return value, nil
+	}
+	metrics.IncCacheLookups(c.name, metrics.CacheMiss)
+
+	ch := c.group.DoChan(fmt.Sprint(key), func() (interface{}, error) {
+		loadCtx, cancel := detach(ctx)
+		defer cancel()
+		value, err := c.load(loadCtx, key)
+		if err != nil {
+			return value, err
+		}
+		c.store(key, value)
+		return value, nil
+	})
+	select {
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			var zero V
+			return zero, res.Err
+		}
+		return res.Val.(V), nil
+	}
+}
+
+// Invalidate drops the keys, the next lookup loads them again.
+func (c *Cache[K, V]) Invalidate(keys ...K) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if elem, ok := c.entries[key]; ok {
+			c.lru.Remove(elem)
+			delete(c.entries, key)
+		}
+	}
+	metrics.SetCacheEntries(c.name, c.lru.Len())
+}
+
+func (c *Cache[K, V]) lookup(key K) (V, bool, bool) {
+	var zero V
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return zero, false, false
+	}
+	e := elem.Value.(*entry[K, V])
+	if time.Now().After(e.expires) {
+		c.lru.Remove(elem)
+		delete(c.entries, key)
+		metrics.SetCacheEntries(c.name, c.lru.Len())
+		return zero, false, false
+	}
+	c.lru.MoveToFront(elem)
+	return e.value, e.found, true
+}
+
+func (c *Cache[K, V]) store(key K, value V) {
+	found := c.found(value)
+	ttl := c.conf.TTL
+	if !found {
+		ttl = c.conf.NegativeTTL
+	}
+	if ttl <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := &entry[K, V]{key: key, value: value, expires: time.Now().Add(ttl), found: found}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = e
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.lru.PushFront(e)
+	for c.conf.MaxSize > 0 && c.lru.Len() > c.conf.MaxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*entry[K, V]).key)
+		metrics.IncCacheEvictions(c.name)
+	}
+	metrics.SetCacheEntries(c.name, c.lru.Len())
+}
+
+// detach keeps the trace and the deadline of the first caller, but not its cancellation, so
+// a canceled caller does not fail the others waiting for the same load.
+func detach(ctx context.Context) (context.Context, context.CancelFunc) {
+	detached := context.WithoutCancel(ctx)
+	if deadline, ok := ctx.Deadline(); ok {
+		return context.WithDeadline(detached, deadline)
+	}
+	return context.WithCancel(detached)
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheHitsAndInvalidation(t *testing.T) {
+	var loads atomic.Int32
+	load := func(_ context.Context, key int64) (int64, error) {
+		loads.Add(1)
+		if key > 100 {
+			return 0, nil
+		}
+		return key + 73, nil
+	}
+	c := New("test", load, func(ownerID int64) bool { return ownerID != 0 },
+		Config{TTL: time.Minute, NegativeTTL: time.Minute, MaxSize: 2})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		owner, err := c.Get(ctx, 1)
+		require.NoError(t, err, "Get failed")
+		require.Equal(t, int64(74), owner, "Owner mismatch")
+		owner, err = c.Get(ctx, 500)
+		require.NoError(t, err, "Get of missing product failed")
+		require.Equal(t, int64(0), owner, "Negative value mismatch")
+	}
+	require.Equal(t, int32(2), loads.Load(), "Loads mismatch")
+
+	c.Invalidate(1)
+	_, err := c.Get(ctx, 1)
+	require.NoError(t, err, "Get after invalidation failed")
+	require.Equal(t, int32(3), loads.Load(), "Invalidated key is not loaded again")
+
+	_, err = c.Get(ctx, 2)
+	require.NoError(t, err, "Get failed")
+	_, err = c.Get(ctx, 500)
+	require.NoError(t, err, "Get of evicted key failed")
+	require.Equal(t, int32(5), loads.Load(), "Least recently used key is not evicted")
+}
+
+func TestCacheSharesConcurrentMisses(t *testing.T) {
+	var loads atomic.Int32
+	release := make(chan struct{})
+	load := func(_ context.Context, _ int64) (bool, error) {
+		loads.Add(1)
+		<-release
+		return true, nil
+	}
+	c := New("test", load, func(isCorrect bool) bool { return isCorrect }, Config{TTL: time.Minute})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			isCorrect, err := c.Get(context.Background(), 7)
+			require.NoError(t, err, "Get failed")
+			require.True(t, isCorrect, "Value mismatch")
+		}()
+	}
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(1), loads.Load(), "Concurrent misses are not shared")
+}
+
+func TestCacheDoesNotKeepErrors(t *testing.T) {
+	var loads atomic.Int32
+	load := func(_ context.Context, _ int64) (bool, error) {
+		if loads.Add(1) == 1 {
+			return false, errors.New("unavailable")
+		}
+		return true, nil
+	}
+	c := New("test", load, func(isCorrect bool) bool { return isCorrect }, Config{TTL: time.Minute})
+
+	_, err := c.Get(context.Background(), 7)
+	require.Error(t, err, "Load error is lost")
+	isCorrect, err := c.Get(context.Background(), 7)
+	require.NoError(t, err, "Error is cached")
+	require.True(t, isCorrect, "Value mismatch")
+}
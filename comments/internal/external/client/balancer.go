@@ -0,0 +1,247 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/balancer"
+	"google.golang.org/grpc/balancer/base"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/serviceconfig"
+	"google.golang.org/grpc/status"
+)
+
+// balancerName is round_robin over the ready endpoints that skips the ejected ones. Endpoints
+// failing the health check are not ready, so they get no calls either.
+const balancerName = "outlier_round_robin"
+
+func init() {
+	balancer.Register(outlierBuilder{})
+}
+
+// OutlierConfig ejects an endpoint after Failures consecutive failed calls for EjectionTime
+// multiplied by the number of its ejections in a row, but not longer than MaxEjectionTime.
+// At most MaxEjectionPercent of the endpoints are ejected at once, 0 Failures turns it off.
+type OutlierConfig struct {
+	Failures           int
+	EjectionTime       time.Duration
+	MaxEjectionTime    time.Duration
+	MaxEjectionPercent int
+}
+
+// lbConfig is the balancer config in the gRPC service config.
+type lbConfig struct {
+	serviceconfig.LoadBalancingConfig `json:"-"`
+
+	Client             string `json:"client"`
+	Failures           int    `json:"failures"`
+	EjectionTimeMs     int64  `json:"ejectionTimeMs"`
+	MaxEjectionTimeMs  int64  `json:"maxEjectionTimeMs"`
+	MaxEjectionPercent int    `json:"maxEjectionPercent"`
+}
+
+func newLBConfig(client string, conf OutlierConfig) *lbConfig {
+	return &lbConfig{
+		Client:             client,
+		Failures:           conf.Failures,
+		EjectionTimeMs:     conf.EjectionTime.Milliseconds(),
+		MaxEjectionTimeMs:  conf.MaxEjectionTime.Milliseconds(),
+		MaxEjectionPercent: conf.MaxEjectionPercent,
+	}
+}
+
+type outlierBuilder struct{}
+
+func (outlierBuilder) Name() string {
+	return balancerName
+}
+
+// Build creates the picker builder per connection, so every client keeps its own ejections.
+func (outlierBuilder) Build(cc balancer.ClientConn, opts balancer.BuildOptions) balancer.Balancer {
+	ej := &ejector{endpoints: make(map[string]*endpoint)}
+	builder := base.NewBalancerBuilder(balancerName, &pickerBuilder{ej: ej}, base.Config{HealthCheck: true})
+	return &outlierBalancer{
+		Balancer: builder.Build(cc, opts),
+		ej:       ej,
+	}
+}
+
+func (outlierBuilder) ParseConfig(js json.RawMessage) (serviceconfig.LoadBalancingConfig, error) {
+	conf := &lbConfig{}
+	if err := json.Unmarshal(js, conf); err != nil {
+		return nil, fmt.Errorf("parse %s config failed: %w", balancerName, err)
+	}
+	if conf.Failures < 0 || conf.EjectionTimeMs < 0 || conf.MaxEjectionPercent < 0 || conf.MaxEjectionPercent > 100 {
+		return nil, fmt.Errorf("invalid %s config: %s", balancerName, js)
+	}
+	return conf, nil
+}
+
+type outlierBalancer struct {
+	balancer.Balancer
+	ej *ejector
+}
+
+func (b *outlierBalancer) UpdateClientConnState(state balancer.ClientConnState) error {
+	if conf, ok := state.BalancerConfig.(*lbConfig); ok {
+		b.ej.configure(*conf)
+	}
+	return b.Balancer.UpdateClientConnState(state)
+}
+
+func (b *outlierBalancer) ExitIdle() {
+	if exitIdler, ok := b.Balancer.(balancer.ExitIdler); ok {
+		exitIdler.ExitIdle()
+	}
+}
+
+type pickerBuilder struct {
+	ej *ejector
+}
+
+func (pb *pickerBuilder) Build(info base.PickerBuildInfo) balancer.Picker {
+	if len(info.ReadySCs) == 0 {
+		return base.NewErrPicker(balancer.ErrNoSubConnAvailable)
+	}
+	p := &picker{
+		ej:   pb.ej,
+		next: rand.Uint32(),
+	}
+	for subConn, subConnInfo := range info.ReadySCs {
+		p.subConns = append(p.subConns, subConn)
+		p.addrs = append(p.addrs, subConnInfo.Address.Addr)
+	}
+	pb.ej.setEndpoints(p.addrs)
+	return p
+}
+
+type picker struct {
+	ej       *ejector
+	subConns []balancer.SubConn
+	addrs    []string
+	next     uint32
+}
+
+// Pick takes the next endpoint that is not ejected. If all of them are ejected, it takes the
+// next one anyway, a call to an ejected endpoint is better than no call.
+func (p *picker) Pick(balancer.PickInfo) (balancer.PickResult, error) {
+	n := uint32(len(p.subConns))
+	start := atomic.AddUint32(&p.next, 1)
+	now := time.Now()
+	idx := start % n
+	for i := uint32(0); i < n; i++ {
+		if j := (start + i) % n; !p.ej.ejected(p.addrs[j], now) {
+			idx = j
+			break
+		}
+	}
+	addr := p.addrs[idx]
+	return balancer.PickResult{
+		SubConn: p.subConns[idx],
+		Done: func(info balancer.DoneInfo) {
+			p.ej.report(addr, info.Err)
+		},
+	}, nil
+}
+
+type endpoint struct {
+	failures     int
+	ejections    int
+	ejectedUntil time.Time
+}
+
+// ejector counts the consecutive failures of the endpoints and ejects the outliers.
+type ejector struct {
+	mu        sync.Mutex
+	conf      lbConfig
+	endpoints map[string]*endpoint
+}
+
+func (e *ejector) configure(conf lbConfig) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.conf = conf
+}
+
+// setEndpoints keeps the state of the ready endpoints, an endpoint that reconnects starts anew.
+func (e *ejector) setEndpoints(addrs []string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	endpoints := make(map[string]*endpoint, len(addrs))
+	for _, addr := range addrs {
+		if ep, ok := e.endpoints[addr]; ok {
+			endpoints[addr] = ep
+		} else {
+			endpoints[addr] = &endpoint{}
+		}
+	}
+	e.endpoints = endpoints
+}
+
+func (e *ejector) ejected(addr string, now time.Time) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ep, ok := e.endpoints[addr]
+	return ok && now.Before(ep.ejectedUntil)
+}
+
+func (e *ejector) report(addr string, err error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	ep, ok := e.endpoints[addr]
+	if !ok || e.conf.Failures <= 0 {
+		return
+	}
+	now := time.Now()
+	if !endpointFailure(err) {
+		ep.failures = 0
+		if !now.Before(ep.ejectedUntil) {
+			ep.ejections = 0
+		}
+		return
+	}
+	ep.failures++
+	if ep.failures < e.conf.Failures || now.Before(ep.ejectedUntil) {
+		return
+	}
+	if e.ejectedCount(now)+1 > len(e.endpoints)*e.conf.MaxEjectionPercent/100 {
+		// the endpoint stays an outlier and is ejected on the next failure if there is room
+		return
+	}
+	ep.ejections++
+	duration := time.Duration(e.conf.EjectionTimeMs*int64(ep.ejections)) * time.Millisecond
+	if maxDuration := time.Duration(e.conf.MaxEjectionTimeMs) * time.Millisecond; maxDuration > 0 {
+		duration = min(duration, maxDuration)
+	}
+	ep.ejectedUntil = now.Add(duration)
+	ep.failures = 0
+	metrics.IncEndpointEjections(e.conf.Client)
+	logger.Warnw(context.Background(), "endpoint ejected", "client", e.conf.Client, "endpoint", addr,
+		"duration", duration.String())
+}
+
+func (e *ejector) ejectedCount(now time.Time) int {
+	count := 0
+	for _, ep := range e.endpoints {
+		if now.Before(ep.ejectedUntil) {
+			count++
+		}
+	}
+	return count
+}
+
+// endpointFailure reports whether the error says the endpoint is unhealthy.
+func endpointFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
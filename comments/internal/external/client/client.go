@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/external/batch"
+	"example/comments/internal/external/cache"
+	mwc "example/comments/internal/external/middlewares"
+	"example/comments/internal/logger"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health" // registers the client side health checking
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+// Discovery modes
+const (
+	// DiscoveryDNS resolves the only endpoint to all its addresses and resolves it again when
+	// the connections fail, so replicas behind one name are found
+	DiscoveryDNS = "dns"
+	// DiscoveryStatic uses the endpoints as they are
+	DiscoveryStatic = "static"
+)
+
+// Balancing policies
+const (
+	BalancingRoundRobin = "round_robin"
+	BalancingPickFirst  = "pick_first"
+)
+
+// Config is the config of an external service client.
+type Config struct {
+	// Endpoints are host:port of the service replicas
+	Endpoints []string
+	Discovery string
+	Balancing string
+	// HealthCheck makes only the replicas serving the service by grpc.health.v1 receive calls
+	HealthCheck bool
+	// Outlier ejection works with round_robin only
+	Outlier OutlierConfig
+	// Timeout bounds a call with all its retries, 0 leaves only the caller deadline
+	Timeout time.Duration
+	Retry   mwc.RetryConfig
+	Breaker mwc.BreakerConfig
+	Batch   batch.Config
+	Cache   cache.Config
+}
+
+// Dial creates the connection to the service replicas. Calls are balanced between the healthy
+// replicas, validated, bounded by the timeout, retried if the method is idempotent, and rejected
+// at once while the circuit breaker is open.
+func Dial(ctx context.Context, name string, conf Config, service grpc.ServiceDesc, idempotent ...string) (*grpc.ClientConn, error) {
+	logger.Infow(ctx, "start "+name+" client", "endpoints", conf.Endpoints,
+		"discovery", conf.Discovery,
+		"balancing", conf.Balancing,
+		"health_check", conf.HealthCheck,
+		"timeout", conf.Timeout,
+		"retry_attempts", conf.Retry.Attempts,
+		"breaker_failures", conf.Breaker.Failures,
+		"batch_window", conf.Batch.Window,
+		"cache_ttl", conf.Cache.TTL)
+	target, opts, err := discovery(name, conf)
+	if err != nil {
+		return nil, err
+	}
+	serviceConf, err := serviceConfig(name, conf, service.ServiceName)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultServiceConfig(serviceConf),
+		grpc.WithChainUnaryInterceptor(
+			mwc.Logger,
+			mwc.Tracer,
+			mwc.Validate,
+			mwc.Deadline(conf.Timeout),
+			mwc.Retry(conf.Retry, methods(service, idempotent...)...),
+			mwc.NewBreaker(name, conf.Breaker).Interceptor))
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		logger.Errorw(ctx, name+" service unavailable", "error", err.Error())
+		return nil, err
+	}
+	return conn, nil
+}
+
+// Ready reports an error unless the connection is ready or idle. An idle connection has had no
+// calls for a while, it is asked to connect, so a broken one is reported on the next check.
+func Ready(conn *grpc.ClientConn) error {
+	switch state := conn.GetState(); state {
+	case connectivity.Ready:
+		return nil
+	case connectivity.Idle:
+		conn.Connect()
+		return nil
+	default:
+		return fmt.Errorf("connection is %s", strings.ToLower(state.String()))
+	}
+}
+
+// discovery returns the target of the endpoints, static endpoints get a resolver of their own.
+func discovery(name string, conf Config) (string, []grpc.DialOption, error) {
+	if len(conf.Endpoints) == 0 {
+		return "", nil, fmt.Errorf("%s client has no endpoints", name)
+	}
+	switch conf.Discovery {
+	case DiscoveryDNS, "":
+		if len(conf.Endpoints) > 1 {
+			return "", nil, fmt.Errorf("%s client: dns discovery takes one endpoint, use static for a list", name)
+		}
+		return "dns:///" + conf.Endpoints[0], nil, nil
+	case DiscoveryStatic:
+		addrs := make([]resolver.Address, 0, len(conf.Endpoints))
+		for _, endpoint := range conf.Endpoints {
+			addrs = append(addrs, resolver.Address{Addr: endpoint})
+		}
+		r := manual.NewBuilderWithScheme(name)
+		r.InitialState(resolver.State{Addresses: addrs})
+		return r.Scheme() + ":///" + name, []grpc.DialOption{grpc.WithResolvers(r)}, nil
+	}
+	return "", nil, fmt.Errorf("%s client: unknown discovery %q", name, conf.Discovery)
+}
+
+// serviceConfig returns the gRPC service config with the balancing policy and the health check.
+func serviceConfig(name string, conf Config, serviceName string) (string, error) {
+	var lb map[string]any
+	switch conf.Balancing {
+	case BalancingRoundRobin, "":
+		lb = map[string]any{balancerName: newLBConfig(name, conf.Outlier)}
+	case BalancingPickFirst:
+		lb = map[string]any{BalancingPickFirst: struct{}{}}
+	default:
+		return "", fmt.Errorf("%s client: unknown balancing %q", name, conf.Balancing)
+	}
+	serviceConf := map[string]any{
+		"loadBalancingConfig": []any{lb},
+	}
+	if conf.HealthCheck {
+		serviceConf["healthCheckConfig"] = map[string]string{"serviceName": serviceName}
+	}
+	data, err := json.Marshal(serviceConf)
+	if err != nil {
+		return "", fmt.Errorf("%s client: marshal service config failed: %w", name, err)
+	}
+	return string(data), nil
+}
+
+// methods returns the full names of the service methods.
+func methods(service grpc.ServiceDesc, names ...string) []string {
+	res := make([]string, 0, len(names))
+	for _, name := range names {
+		res = append(res, "/"+service.ServiceName+"/"+name)
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+package client
+
+import (
+	"context"
+	external "example/comments/internal/external/api/v1"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+type usersReplica struct {
+	external.UnimplementedUsersServer
+	calls  atomic.Int64
+	fail   bool
+	health *health.Server
+	addr   string
+}
+
+func (r *usersReplica) CheckUserID(_ context.Context, _ *external.CheckUserIDRequest) (*external.CheckUserIDResponse, error) {
+	r.calls.Add(1)
+	if r.fail {
+		return nil, status.Error(codes.Unavailable, "replica is down")
+	}
+	return &external.CheckUserIDResponse{IsCorrect: true}, nil
+}
+
+func startReplica(t *testing.T, fail bool) *usersReplica {
+	list, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err, "Can not listen")
+	replica := &usersReplica{fail: fail, health: health.NewServer(), addr: list.Addr().String()}
+	replica.health.SetServingStatus(external.Users_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	server := grpc.NewServer()
+	external.RegisterUsersServer(server, replica)
+	healthpb.RegisterHealthServer(server, replica.health)
+	go func() {
+		_ = server.Serve(list)
+	}()
+	t.Cleanup(server.Stop)
+	return replica
+}
+
+func dialReplicas(t *testing.T, outlier OutlierConfig, replicas ...*usersReplica) external.UsersClient {
+	conf := Config{
+		Discovery:   DiscoveryStatic,
+		Balancing:   BalancingRoundRobin,
+		HealthCheck: true,
+		Outlier:     outlier,
+	}
+	for _, replica := range replicas {
+		conf.Endpoints = append(conf.Endpoints, replica.addr)
+	}
+	conn, err := Dial(context.Background(), "users", conf, external.Users_ServiceDesc)
+	require.NoError(t, err, "Dial failed")
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return external.NewUsersClient(conn)
+}
+
+func checkUsers(client external.UsersClient, count int) {
+	for i := 0; i < count; i++ {
+		_, _ = client.CheckUserID(context.Background(), &external.CheckUserIDRequest{UserID: 1})
+	}
+}
+
+func TestRoundRobinAndHealthCheck(t *testing.T) {
+	first, second := startReplica(t, false), startReplica(t, false)
+	client := dialReplicas(t, OutlierConfig{}, first, second)
+
+	require.Eventually(t, func() bool {
+		checkUsers(client, 10)
+		return first.calls.Load() > 0 && second.calls.Load() > 0
+	}, 5*time.Second, 10*time.Millisecond, "Calls are not balanced")
+
+	second.health.SetServingStatus(external.Users_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_NOT_SERVING)
+	require.Eventually(t, func() bool {
+		calls := second.calls.Load()
+		checkUsers(client, 10)
+		return second.calls.Load() == calls
+	}, 5*time.Second, 10*time.Millisecond, "Not serving replica gets calls")
+}
+
+func TestOutlierEjection(t *testing.T) {
+	healthy, failing := startReplica(t, false), startReplica(t, true)
+	client := dialReplicas(t, OutlierConfig{
+		Failures:           2,
+		EjectionTime:       time.Minute,
+		MaxEjectionPercent: 50,
+	}, healthy, failing)
+
+	require.Eventually(t, func() bool {
+		checkUsers(client, 10)
+		return failing.calls.Load() >= 2
+	}, 5*time.Second, 10*time.Millisecond, "Failing replica gets no calls")
+	calls := failing.calls.Load()
+	checkUsers(client, 20)
+	require.Equal(t, calls, failing.calls.Load(), "Ejected replica gets calls")
+}
+
+func TestEjectionLimit(t *testing.T) {
+	ej := &ejector{conf: lbConfig{Failures: 1, EjectionTimeMs: 60000, MaxEjectionPercent: 50}}
+	ej.setEndpoints([]string{"a", "b"})
+	now := time.Now()
+
+	ej.report("a", status.Error(codes.Unavailable, "down"))
+	require.True(t, ej.ejected("a", now), "Outlier is not ejected")
+	ej.report("b", status.Error(codes.Unavailable, "down"))
+	require.False(t, ej.ejected("b", now), "Ejected more than the max percent")
+	ej.report("b", status.Error(codes.InvalidArgument, "bad request"))
+	ej.report("b", nil)
+	require.Zero(t, ej.endpoints["b"].failures, "Success does not reset failures")
+}
@@ -0,0 +1,122 @@
+package mwc
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned without calling the service while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// Circuit breaker states
+const (
+	CircuitClosed   = "closed"
+	CircuitHalfOpen = "half_open"
+	CircuitOpen     = "open"
+)
+
+// BreakerConfig opens the circuit after Failures consecutive failed calls, 0 turns the breaker
+// off. After OpenTimeout one probe call is let through, its result closes or reopens the circuit.
+type BreakerConfig struct {
+	Failures    int
+	OpenTimeout time.Duration
+}
+
+// Breaker is the circuit breaker of one service client.
+type Breaker struct {
+	name string
+	conf BreakerConfig
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+}
+
+func NewBreaker(name string, conf BreakerConfig) *Breaker {
+	metrics.SetCircuitState(name, CircuitClosed)
+	return &Breaker{
+		name:  name,
+		conf:  conf,
+		state: CircuitClosed,
+	}
+}
+
+func (b *Breaker) Interceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if b.conf.Failures <= 0 {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	if !b.allow(ctx) {
+		return ErrCircuitOpen
+	}
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	b.done(ctx, err)
+	return err
+}
+
+func (b *Breaker) allow(ctx context.Context) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case CircuitOpen:
+		if time.Since(b.openedAt) < b.conf.OpenTimeout {
+			return false
+		}
+		b.setState(ctx, CircuitHalfOpen)
+		return true
+	case CircuitHalfOpen:
+		// only the probe call goes through until its result is known
+		return false
+	}
+	return true
+}
+
+func (b *Breaker) done(ctx context.Context, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if status.Code(err) == codes.Canceled {
+		// the caller gave up, the call says nothing about the service
+		if b.state == CircuitHalfOpen {
+			b.setState(ctx, CircuitOpen)
+		}
+		return
+	}
+	if !failure(err) {
+		b.failures = 0
+		if b.state != CircuitClosed {
+			b.setState(ctx, CircuitClosed)
+		}
+		return
+	}
+	b.failures++
+	if b.state == CircuitHalfOpen || b.failures >= b.conf.Failures {
+		b.openedAt = time.Now()
+		b.setState(ctx, CircuitOpen)
+	}
+}
+
+func (b *Breaker) setState(ctx context.Context, state string) {
+	if b.state != state {
+		logger.Warnw(ctx, "circuit breaker state changed", "client", b.name, "from", b.state, "to", state)
+	}
+	b.state = state
+	metrics.SetCircuitState(b.name, state)
+}
+
+// failure reports whether the error says the service is unhealthy, errors of the request
+// itself do not count.
+func failure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	}
+	return false
+}
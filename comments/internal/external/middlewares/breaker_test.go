@@ -0,0 +1,49 @@
+package mwc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBreakerOpensAndProbes(t *testing.T) {
+	b := NewBreaker("test", BreakerConfig{Failures: 2, OpenTimeout: 20 * time.Millisecond})
+	calls := 0
+	fail := true
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		if fail {
+			return status.Error(codes.Unavailable, "down")
+		}
+		return nil
+	}
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		require.Error(t, b.Interceptor(ctx, "/m", nil, nil, nil, invoker), "Failure is lost")
+	}
+	require.ErrorIs(t, b.Interceptor(ctx, "/m", nil, nil, nil, invoker), ErrCircuitOpen, "Circuit is not open")
+	require.Equal(t, 2, calls, "Open circuit calls the service")
+
+	time.Sleep(25 * time.Millisecond)
+	fail = false
+	require.NoError(t, b.Interceptor(ctx, "/m", nil, nil, nil, invoker), "Probe failed")
+	require.NoError(t, b.Interceptor(ctx, "/m", nil, nil, nil, invoker), "Circuit is not closed after probe")
+	require.Equal(t, 4, calls, "Calls mismatch")
+}
+
+func TestBreakerIgnoresRequestErrors(t *testing.T) {
+	b := NewBreaker("test", BreakerConfig{Failures: 1, OpenTimeout: time.Minute})
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		return status.Error(codes.InvalidArgument, "bad request")
+	}
+	for i := 0; i < 3; i++ {
+		err := b.Interceptor(context.Background(), "/m", nil, nil, nil, invoker)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), "Request error mismatch")
+	}
+}
@@ -0,0 +1,83 @@
+package mwc
+
+import (
+	"context"
+	"example/comments/internal/logger"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryConfig sets the number of attempts of a call, 1 or less turns the retries off, and
+// the exponential backoff between them.
+type RetryConfig struct {
+	Attempts       int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// AttemptTimeout bounds every attempt, so a hung attempt leaves time for the next one
+	AttemptTimeout time.Duration
+}
+
+// Retry repeats the idempotent methods failed with Unavailable or DeadlineExceeded while the
+// call deadline allows. Other methods are called once.
+func Retry(conf RetryConfig, idempotent ...string) grpc.UnaryClientInterceptor {
+	methods := make(map[string]struct{}, len(idempotent))
+	for _, method := range idempotent {
+		methods[method] = struct{}{}
+	}
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attempts := conf.Attempts
+		if _, ok := methods[method]; !ok || attempts < 1 {
+			attempts = 1
+		}
+		backoff := conf.InitialBackoff
+		for attempt := 1; ; attempt++ {
+			err := invokeAttempt(ctx, conf.AttemptTimeout, method, req, reply, cc, invoker, opts...)
+			if err == nil || attempt >= attempts || !retryable(err) || ctx.Err() != nil {
+				return err
+			}
+			delay := jitter(backoff)
+			if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= delay {
+				return err
+			}
+			logger.Warnw(ctx, "grpc request retry", "method", method, "attempt", attempt, "backoff", delay, "error", err)
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			backoff = min(backoff*2, conf.MaxBackoff)
+		}
+	}
+}
+
+func invokeAttempt(ctx context.Context, timeout time.Duration, method string, req, reply any, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return invoker(ctx, method, req, reply, cc, opts...)
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
+// jitter spreads the retries of concurrent calls over [backoff/2, backoff].
+func jitter(backoff time.Duration) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
@@ -0,0 +1,42 @@
+package mwc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryIdempotentMethods(t *testing.T) {
+	retry := Retry(RetryConfig{Attempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}, "/read")
+	calls := 0
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		if calls < 3 {
+			return status.Error(codes.Unavailable, "down")
+		}
+		return nil
+	}
+	require.NoError(t, retry(context.Background(), "/read", nil, nil, nil, invoker), "Retries failed")
+	require.Equal(t, 3, calls, "Attempts mismatch")
+
+	calls = 0
+	err := retry(context.Background(), "/write", nil, nil, nil, invoker)
+	require.Equal(t, codes.Unavailable, status.Code(err), "Error mismatch")
+	require.Equal(t, 1, calls, "Not idempotent method is retried")
+}
+
+func TestRetryStopsOnRequestErrors(t *testing.T) {
+	retry := Retry(RetryConfig{Attempts: 3, InitialBackoff: time.Millisecond}, "/read")
+	calls := 0
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.InvalidArgument, "bad request")
+	}
+	require.Error(t, retry(context.Background(), "/read", nil, nil, nil, invoker), "Error is lost")
+	require.Equal(t, 1, calls, "Request error is retried")
+}
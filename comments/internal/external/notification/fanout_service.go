@@ -0,0 +1,72 @@
+package notification
+
+import (
+	"context"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"time"
+)
+
+type FanOutRepository interface {
+	GetPendingFanOutTasks(_ context.Context) ([]model.FanOutTask, error)
+	FanOutBatch(_ context.Context, task model.FanOutTask, batchSize int32) (int64, bool, error)
+}
+
+type FanOutConfig struct {
+	Timer     time.Duration
+	BatchSize int
+}
+
+// FanOutService enqueues notifications for product subscribers. Each batch is a separate
+// short transaction, so products with many followers do not slow down CreateComment and
+// an interrupted fan-out continues from the saved cursor.
+type FanOutService struct {
+	rep  FanOutRepository
+	conf FanOutConfig
+}
+
+func StartFanOutService(ctx context.Context, rep FanOutRepository, conf FanOutConfig) {
+	fanOutService := &FanOutService{
+		rep:  rep,
+		conf: conf,
+	}
+	go func(s *FanOutService) {
+		ticker := time.NewTicker(s.conf.Timer)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Infow(ctx, "fan-out service context closed")
+				return
+			case <-ticker.C:
+				s.FanOut(ctx)
+			}
+		}
+	}(fanOutService)
+}
+
+func (s *FanOutService) FanOut(ctx context.Context) {
+	tasks, err := s.rep.GetPendingFanOutTasks(ctx)
+	if err != nil {
+		logger.Warnw(ctx, "can not get fan-out tasks", "error", err.Error())
+		return
+	}
+	for _, task := range tasks {
+		for {
+			if ctx.Err() != nil {
+				return
+			}
+			lastUserID, done, err := s.rep.FanOutBatch(ctx, task, int32(s.conf.BatchSize))
+			if err != nil {
+				logger.Warnw(ctx, "fan-out batch failed", "error", err.Error(),
+					"comment_id", task.CommentID, "last_user_id", task.LastUserID)
+				break
+			}
+			task.LastUserID = lastUserID
+			if done {
+				logger.Infow(ctx, "subscribers notified", "comment_id", task.CommentID, "product_id", task.ProductID)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package notification
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"example/comments/internal/app/config"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/IBM/sarama"
+	"github.com/xdg-go/scram"
+)
+
+// KafkaConfig is the connection to the cluster shared by the producers, the consumers and the admin.
+type KafkaConfig struct {
+	Brokers []string
+	SASL    SASLConfig
+	TLS     TLSConfig
+	Topics  TopicsConfig
+}
+
+type SASLConfig struct {
+	Enabled   bool
+	Mechanism string
+	User      string
+	Password  string
+}
+
+type TLSConfig struct {
+	Enabled            bool
+	CAFile             string
+	CertFile           string
+	KeyFile            string
+	InsecureSkipVerify bool
+}
+
+func NewKafkaConfig(conf *config.Config) KafkaConfig {
+	kafkaConf := conf.KafkaConf
+	return KafkaConfig{
+		Brokers: strings.Split(kafkaConf.Brokers, ","),
+		SASL: SASLConfig{
+			Enabled:   kafkaConf.SASL.Enabled,
+			Mechanism: kafkaConf.SASL.Mechanism,
+			User:      kafkaConf.SASL.User,
+			Password:  kafkaConf.SASL.Password,
+		},
+		TLS: TLSConfig{
+			Enabled:            kafkaConf.TLS.Enabled,
+			CAFile:             kafkaConf.TLS.CAFile,
+			CertFile:           kafkaConf.TLS.CertFile,
+			KeyFile:            kafkaConf.TLS.KeyFile,
+			InsecureSkipVerify: kafkaConf.TLS.InsecureSkipVerify,
+		},
+		Topics: TopicsConfig{
+			Verify:            kafkaConf.Topics.Verify,
+			Create:            kafkaConf.Topics.Create,
+			Partitions:        kafkaConf.Topics.Partitions,
+			ReplicationFactor: kafkaConf.Topics.ReplicationFactor,
+			RetentionMs:       kafkaConf.Topics.RetentionMs,
+		},
+	}
+}
+
+// NewSaramaConfig creates a sarama config with the connection security settings applied.
+func NewSaramaConfig(conf KafkaConfig) (*sarama.Config, error) {
+	config := sarama.NewConfig()
+	if err := applySASL(config, conf.SASL); err != nil {
+		return nil, err
+	}
+	if err := applyTLS(config, conf.TLS); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func applySASL(config *sarama.Config, conf SASLConfig) error {
+	if !conf.Enabled {
+		return nil
+	}
+	config.Net.SASL.Enable = true
+	config.Net.SASL.User = conf.User
+	config.Net.SASL.Password = conf.Password
+	config.Net.SASL.Handshake = true
+	switch sarama.SASLMechanism(conf.Mechanism) {
+	case "", sarama.SASLTypePlaintext:
+		config.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+	case sarama.SASLTypeSCRAMSHA256:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA256
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: sha256.New}
+		}
+	case sarama.SASLTypeSCRAMSHA512:
+		config.Net.SASL.Mechanism = sarama.SASLTypeSCRAMSHA512
+		config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+			return &scramClient{HashGeneratorFcn: sha512.New}
+		}
+	default:
+		return fmt.Errorf("unsupported sasl mechanism: %s", conf.Mechanism)
+	}
+	return nil
+}
+
+func applyTLS(config *sarama.Config, conf TLSConfig) error {
+	if !conf.Enabled {
+		return nil
+	}
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: conf.InsecureSkipVerify,
+	}
+	if conf.CAFile != "" {
+		ca, err := os.ReadFile(filepath.Clean(conf.CAFile))
+		if err != nil {
+			return fmt.Errorf("read kafka ca file failed: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return errors.New("kafka ca file contains no certificates")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if conf.CertFile != "" || conf.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(conf.CertFile, conf.KeyFile)
+		if err != nil {
+			return fmt.Errorf("load kafka client certificate failed: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	config.Net.TLS.Enable = true
+	config.Net.TLS.Config = tlsConfig
+	return nil
+}
+
+type scramClient struct {
+	*scram.Client
+	*scram.ClientConversation
+	scram.HashGeneratorFcn
+}
+
+func (c *scramClient) Begin(userName, password, authzID string) error {
+	client, err := c.HashGeneratorFcn.NewClient(userName, password, authzID)
+	if err != nil {
+		return err
+	}
+	c.Client = client
+	c.ClientConversation = client.NewConversation()
+	return nil
+}
+
+func (c *scramClient) Step(challenge string) (string, error) {
+	return c.ClientConversation.Step(challenge)
+}
+
+func (c *scramClient) Done() bool {
+	return c.ClientConversation.Done()
+}
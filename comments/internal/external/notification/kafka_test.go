@@ -0,0 +1,99 @@
+package notification
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSaramaConfigPlain(t *testing.T) {
+	config, err := NewSaramaConfig(KafkaConfig{})
+	require.NoError(t, err, "NewSaramaConfig failed")
+	require.False(t, config.Net.SASL.Enable, "SASL enabled")
+	require.False(t, config.Net.TLS.Enable, "TLS enabled")
+}
+
+func TestNewSaramaConfigSASL(t *testing.T) {
+	config, err := NewSaramaConfig(KafkaConfig{SASL: SASLConfig{Enabled: true, User: "comments", Password: "secret"}})
+	require.NoError(t, err, "NewSaramaConfig failed")
+	require.True(t, config.Net.SASL.Enable, "SASL disabled")
+	require.Equal(t, sarama.SASLMechanism(sarama.SASLTypePlaintext), config.Net.SASL.Mechanism, "Default mechanism mismatch")
+	require.Equal(t, "comments", config.Net.SASL.User, "User mismatch")
+	require.Equal(t, "secret", config.Net.SASL.Password, "Password mismatch")
+
+	for _, mechanism := range []string{sarama.SASLTypeSCRAMSHA256, sarama.SASLTypeSCRAMSHA512} {
+		config, err = NewSaramaConfig(KafkaConfig{SASL: SASLConfig{Enabled: true, Mechanism: mechanism, User: "comments", Password: "secret"}})
+		require.NoError(t, err, "NewSaramaConfig failed")
+		require.Equal(t, sarama.SASLMechanism(mechanism), config.Net.SASL.Mechanism, "Mechanism mismatch")
+		client := config.Net.SASL.SCRAMClientGeneratorFunc()
+		require.NoError(t, client.Begin("comments", "secret", ""), "SCRAM client begin failed")
+		first, err := client.Step("")
+		require.NoError(t, err, "SCRAM client first step failed")
+		require.Contains(t, first, "n=comments", "SCRAM first message mismatch")
+		require.False(t, client.Done(), "SCRAM conversation is done too early")
+	}
+
+	_, err = NewSaramaConfig(KafkaConfig{SASL: SASLConfig{Enabled: true, Mechanism: "GSSAPI"}})
+	require.Error(t, err, "Unsupported mechanism accepted")
+}
+
+func TestNewSaramaConfigTLS(t *testing.T) {
+	certFile, keyFile := writeCertificate(t)
+	config, err := NewSaramaConfig(KafkaConfig{TLS: TLSConfig{
+		Enabled:  true,
+		CAFile:   certFile,
+		CertFile: certFile,
+		KeyFile:  keyFile,
+	}})
+	require.NoError(t, err, "NewSaramaConfig failed")
+	require.True(t, config.Net.TLS.Enable, "TLS disabled")
+	require.Equal(t, uint16(tls.VersionTLS12), config.Net.TLS.Config.MinVersion, "Min version mismatch")
+	require.NotNil(t, config.Net.TLS.Config.RootCAs, "CA is not loaded")
+	require.Equal(t, 1, len(config.Net.TLS.Config.Certificates), "Client certificate is not loaded")
+	require.False(t, config.Net.TLS.Config.InsecureSkipVerify, "Verification skipped")
+
+	empty := filepath.Join(t.TempDir(), "empty.pem")
+	require.NoError(t, os.WriteFile(empty, []byte("no certificates"), 0o600))
+	_, err = NewSaramaConfig(KafkaConfig{TLS: TLSConfig{Enabled: true, CAFile: empty}})
+	require.Error(t, err, "CA file without certificates accepted")
+
+	_, err = NewSaramaConfig(KafkaConfig{TLS: TLSConfig{Enabled: true, CertFile: certFile}})
+	require.Error(t, err, "Certificate without key accepted")
+}
+
+// writeCertificate writes a self-signed certificate and its key, the certificate is its own CA.
+func writeCertificate(t *testing.T) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kafka"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600))
+	return certFile, keyFile
+}
@@ -1,10 +1,167 @@
 package notification
 
-import "time"
+import (
+	"encoding/json"
+	"example/comments/internal/model"
+	"example/comments/internal/outbox"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Event types, sent in the EventTypeHeader kafka header
+const (
+	EventTypeHeader = outbox.EventTypeHeader
+
+	EventCommentCreated = "comment.created"
+	EventCommentDigest  = "comment.digest"
+)
+
+// TopicNotifications is the logical outbox topic of recipient notifications and digests, it is
+// routed to kafka.order_topic.
+const TopicNotifications = "notifications"
+
+// Webhook events of the recipient notifications besides EventCommentCreated, sent in the
+// EventHeader webhook header
+const (
+	EventCommentReply        = "comment.reply"
+	EventCommentMention      = "comment.mention"
+	EventCommentOwnerAnswer  = "comment.owner_answer"
+	EventCommentSubscription = "comment.subscription"
+)
+
+// EventForReason returns the webhook event of a notification reason. New comments to the owner,
+// and reasons unknown to this version, are reported as EventCommentCreated.
+func EventForReason(reason string) string {
+	switch reason {
+	case model.ReasonReply:
+		return EventCommentReply
+	case model.ReasonMention:
+		return EventCommentMention
+	case model.ReasonOwnerAnswer:
+		return EventCommentOwnerAnswer
+	case model.ReasonSubscription:
+		return EventCommentSubscription
+	}
+	return EventCommentCreated
+}
+
+// Delivery states reported by receipts
+const (
+	StateDelivered = "delivered"
+	StateRead      = "read"
+)
 
 type CommentNotification struct {
-	ID        int64     `json:"id"`
-	OwnerID   int64     `json:"owner_id"`
-	CommentID int64     `json:"comment_id"`
-	CreatedTS time.Time `json:"operation_time"`
+	ID          int64     `json:"id"`
+	RecipientID int64     `json:"recipient_id"`
+	Reason      string    `json:"reason"`
+	CommentID   int64     `json:"comment_id"`
+	ProductID   int64     `json:"product_id"`
+	CreatedTS   time.Time `json:"operation_time"`
+	TraceParent string    `json:"-"`
+}
+
+// commentNotificationJSON adds owner_id, the recipient field of the payload before notifications
+// had reasons. It is set for the product owner notifications only, consumers reading owner_id keep
+// getting exactly the notifications they got before.
+type commentNotificationJSON struct {
+	plainCommentNotification
+	OwnerID int64 `json:"owner_id,omitempty"`
+}
+
+type plainCommentNotification CommentNotification
+
+func (n CommentNotification) MarshalJSON() ([]byte, error) {
+	payload := commentNotificationJSON{plainCommentNotification: plainCommentNotification(n)}
+	if n.Reason == model.ReasonNewComment {
+		payload.OwnerID = n.RecipientID
+	}
+	return json.Marshal(payload)
+}
+
+// UnmarshalJSON reads messages published before recipient_id too, they were sent to the owner
+// about a new comment.
+func (n *CommentNotification) UnmarshalJSON(data []byte) error {
+	payload := commentNotificationJSON{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	*n = CommentNotification(payload.plainCommentNotification)
+	if n.RecipientID == 0 && payload.OwnerID != 0 {
+		n.RecipientID = payload.OwnerID
+		n.Reason = model.ReasonNewComment
+	}
+	return nil
+}
+
+// DigestNotification summarizes the recipient notifications accumulated over a digest period.
+type DigestNotification struct {
+	ID          string `json:"id"`
+	RecipientID int64  `json:"recipient_id"`
+	// NotificationIDs are acknowledged by delivery receipts
+	NotificationIDs []int64   `json:"notification_ids"`
+	Mode            string    `json:"mode"`
+	Count           int       `json:"count"`
+	CommentIDs      []int64   `json:"comment_ids"`
+	ProductIDs      []int64   `json:"product_ids"`
+	From            time.Time `json:"from"`
+	To              time.Time `json:"to"`
+}
+
+// digestNotificationJSON keeps owner_id in the payload, digests follow the owner preferences and
+// are always sent to the owner.
+type digestNotificationJSON struct {
+	plainDigestNotification
+	OwnerID int64 `json:"owner_id"`
+}
+
+type plainDigestNotification DigestNotification
+
+func (d DigestNotification) MarshalJSON() ([]byte, error) {
+	return json.Marshal(digestNotificationJSON{plainDigestNotification: plainDigestNotification(d), OwnerID: d.RecipientID})
+}
+
+func (d *DigestNotification) UnmarshalJSON(data []byte) error {
+	payload := digestNotificationJSON{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return err
+	}
+	*d = DigestNotification(payload.plainDigestNotification)
+	if d.RecipientID == 0 {
+		d.RecipientID = payload.OwnerID
+	}
+	return nil
+}
+
+// NewDigestNotification aggregates notifications sorted by creation time. The ID is derived
+// from the last notification, so a digest republished after a failure keeps its identity.
+func NewDigestNotification(recipientID int64, mode string, ntfs []CommentNotification) DigestNotification {
+	digest := DigestNotification{
+		ID:              fmt.Sprintf("digest:%d:%d", recipientID, ntfs[len(ntfs)-1].ID),
+		RecipientID:     recipientID,
+		Mode:            mode,
+		Count:           len(ntfs),
+		NotificationIDs: make([]int64, len(ntfs)),
+		CommentIDs:      make([]int64, len(ntfs)),
+		ProductIDs:      make([]int64, 0),
+		From:            ntfs[0].CreatedTS,
+		To:              ntfs[len(ntfs)-1].CreatedTS,
+	}
+	for i, val := range ntfs {
+		digest.NotificationIDs[i] = val.ID
+		digest.CommentIDs[i] = val.CommentID
+		if val.ProductID != 0 && !slices.Contains(digest.ProductIDs, val.ProductID) {
+			digest.ProductIDs = append(digest.ProductIDs, val.ProductID)
+		}
+	}
+	return digest
+}
+
+// DeliveryReceipt reports that notifications reached the recipient or were read by them.
+// Time is the moment of the state change, the message timestamp is used when it is empty.
+type DeliveryReceipt struct {
+	NotificationIDs []int64   `json:"notification_ids"`
+	State           string    `json:"state"`
+	Time            time.Time `json:"time"`
 }
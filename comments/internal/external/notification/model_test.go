@@ -0,0 +1,49 @@
+package notification
+
+import (
+	"encoding/json"
+	"example/comments/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommentNotificationOwnerID(t *testing.T) {
+	ntf := CommentNotification{ID: 1, RecipientID: 173, Reason: model.ReasonNewComment, CommentID: 42,
+		CreatedTS: time.Date(2025, 3, 14, 15, 9, 0, 0, time.UTC), TraceParent: "00-trace"}
+	data, err := json.Marshal(ntf)
+	require.NoError(t, err, "Marshal failed")
+	fields := map[string]any{}
+	require.NoError(t, json.Unmarshal(data, &fields))
+	require.Equal(t, float64(173), fields["owner_id"], "Owner id mismatch")
+	require.Equal(t, float64(173), fields["recipient_id"], "Recipient id mismatch")
+	require.NotContains(t, fields, "TraceParent", "Trace parent is serialized")
+
+	decoded := CommentNotification{}
+	require.NoError(t, json.Unmarshal(data, &decoded), "Unmarshal failed")
+	ntf.TraceParent = ""
+	require.Equal(t, ntf, decoded, "Round trip mismatch")
+
+	data, err = json.Marshal(CommentNotification{ID: 2, RecipientID: 174, Reason: model.ReasonReply})
+	require.NoError(t, err, "Marshal failed")
+	require.NotContains(t, string(data), "owner_id", "Reply is sent to owner_id consumers")
+}
+
+func TestCommentNotificationLegacyPayload(t *testing.T) {
+	decoded := CommentNotification{}
+	require.NoError(t, json.Unmarshal([]byte(`{"id":1,"owner_id":173,"comment_id":42}`), &decoded), "Unmarshal failed")
+	require.Equal(t, int64(173), decoded.RecipientID, "Recipient mismatch")
+	require.Equal(t, model.ReasonNewComment, decoded.Reason, "Reason mismatch")
+}
+
+func TestDigestNotificationOwnerID(t *testing.T) {
+	digest := NewDigestNotification(173, model.ModeDailyDigest, []CommentNotification{{ID: 1, RecipientID: 173, CommentID: 42}})
+	data, err := json.Marshal(digest)
+	require.NoError(t, err, "Marshal failed")
+	require.Contains(t, string(data), `"owner_id":173`, "Owner id mismatch")
+
+	decoded := DigestNotification{}
+	require.NoError(t, json.Unmarshal(data, &decoded), "Unmarshal failed")
+	require.Equal(t, digest, decoded, "Round trip mismatch")
+}
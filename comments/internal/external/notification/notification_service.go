@@ -2,138 +2,126 @@ package notification
 
 import (
 	"context"
-	"encoding/json"
 	"example/comments/internal/logger"
-	"fmt"
-	"strconv"
+	"example/comments/internal/metrics"
+	"example/comments/internal/model"
+	"example/comments/internal/trace"
 	"time"
 
-	"github.com/IBM/sarama"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 type CommentNotificationRepository interface {
-	GetCommentNotification(_ context.Context) ([]CommentNotification, error)
-	MarkNotificationAsSend(_ context.Context, notificationID int64) error
+	EnqueueNotifications(_ context.Context) ([]CommentNotification, error)
+	ClaimDueDigests(_ context.Context, now time.Time, leaseUntil time.Time) ([]model.NotificationPreferences, error)
+	GetRecipientNotification(_ context.Context, recipientID int64) ([]CommentNotification, error)
+	CompleteDigest(_ context.Context, ownerID int64, digest *DigestNotification, nextDigestAt time.Time) error
 }
 
-type OrderNotificationService struct {
-	rep      CommentNotificationRepository
-	updateCh chan int64
-	ticker   *time.Ticker
-	topic    string
-	prod     sarama.SyncProducer
+// digestLease hides a claimed digest from other ticks and replicas, a digest that failed to
+// complete is retried after it.
+const digestLease = time.Minute
+
+// NotificationScheduler moves due notifications and digests to the outbox under
+// TopicNotifications, the outbox publisher sends them to Kafka.
+type NotificationScheduler struct {
+	rep   CommentNotificationRepository
+	timer time.Duration
 }
 
-func StartNotificationService(ctx context.Context,
-	rep CommentNotificationRepository,
-	brokers []string,
-	topic string,
-	maxCount int,
-	timer int) {
-	orderService := &OrderNotificationService{
-		rep:      rep,
-		updateCh: make(chan int64, maxCount),
-		ticker:   time.NewTicker(time.Duration(timer) * time.Millisecond),
-		topic:    topic,
-	}
-	var err error
-	orderService.prod, err = orderService.newSyncProducer(brokers)
-	if err != nil {
-		logger.Warnw(ctx, "create producer failed", "error", err.Error())
-		return
+func NewNotificationScheduler(rep CommentNotificationRepository, timer time.Duration) *NotificationScheduler {
+	return &NotificationScheduler{
+		rep:   rep,
+		timer: timer,
 	}
+}
 
-	go func(s *OrderNotificationService) {
+// Start schedules the notifications every timer until ctx is canceled.
+func (s *NotificationScheduler) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(s.timer)
+		defer ticker.Stop()
 		for {
 			select {
 			case <-ctx.Done():
-				logger.Infow(ctx, "notification service context closed")
-				close(s.updateCh)
-				s.prod.Close()
-				s.ticker.Stop()
+				logger.Infow(ctx, "notification scheduler context closed")
 				return
-			case <-s.ticker.C:
-				go func(appCtx context.Context) {
-					ctx, cancel := context.WithTimeout(appCtx, 100*time.Millisecond)
-					s.SendNotification(ctx)
-					cancel()
-				}(ctx)
-			case notificationID := <-s.updateCh:
-				ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
-				s.MarkNotificationAsSend(ctx, notificationID)
-				cancel()
+			case <-ticker.C:
+				s.Schedule(ctx, time.Now().UTC())
 			}
 		}
-	}(orderService)
+	}()
 }
 
-func (s *OrderNotificationService) SendNotification(ctx context.Context) {
-	if ctx.Err() != nil {
-		return
+// Schedule enqueues the notifications of immediate mode recipients and the digests whose
+// period is over at now.
+func (s *NotificationScheduler) Schedule(ctx context.Context, now time.Time) {
+	ntfs, err := s.rep.EnqueueNotifications(ctx)
+	if err != nil {
+		logger.Warnw(ctx, "can not enqueue notifications", "error", err.Error())
 	}
+	for _, val := range ntfs {
+		metrics.ObserveNotificationLatency(metrics.StagePublished, val.CreatedTS, now)
+	}
+	if len(ntfs) > 0 {
+		logger.Infow(ctx, "notifications enqueued", "count", len(ntfs))
+	}
+	s.ScheduleDigests(ctx, now)
+}
 
-	ntfs, err := s.rep.GetCommentNotification(ctx)
+// ScheduleDigests enqueues one summary event per digest owner whose period is over. Owners in
+// their quiet hours are not claimed and are picked up on the first tick after the quiet hours
+// end. The period is advanced even when nothing was accumulated.
+func (s *NotificationScheduler) ScheduleDigests(ctx context.Context, now time.Time) {
+	prefs, err := s.rep.ClaimDueDigests(ctx, now, now.Add(digestLease))
 	if err != nil {
-		logger.Warnw(ctx, "can not get notifications", "error", err.Error())
-		return
-	}
-	if len(ntfs) == 0 {
+		logger.Warnw(ctx, "can not claim due digests", "error", err.Error())
 		return
 	}
-	for _, val := range ntfs {
+	for _, pref := range prefs {
 		if ctx.Err() != nil {
 			return
 		}
-		bytes, err := json.Marshal(val)
+		ntfs, err := s.rep.GetRecipientNotification(ctx, pref.OwnerID)
 		if err != nil {
-			logger.Warnw(ctx, "marshal notification failed", "error", err.Error())
-			break
+			logger.Warnw(ctx, "can not get owner notifications", "error", err.Error(), "owner_id", pref.OwnerID)
+			continue
 		}
-		msg := &sarama.ProducerMessage{
-			Topic:     s.topic,
-			Key:       sarama.StringEncoder(strconv.FormatInt(val.ID, 10)),
-			Value:     sarama.ByteEncoder(bytes),
-			Timestamp: time.Now(),
+		next := model.NextDigestAt(pref.Mode, now, pref.Location())
+		if len(ntfs) == 0 {
+			err = s.rep.CompleteDigest(ctx, pref.OwnerID, nil, next)
+		} else {
+			err = s.completeDigest(ctx, NewDigestNotification(pref.OwnerID, pref.Mode, ntfs), ntfs, next)
 		}
-		partition, offset, err := s.prod.SendMessage(msg)
 		if err != nil {
-			logger.Warnw(ctx, "can not send notification", "error", err.Error())
-			continue
+			logger.Warnw(ctx, "can not complete digest", "error", err.Error(), "owner_id", pref.OwnerID)
 		}
-		s.updateCh <- val.ID
-		logger.Infow(ctx, "send notification: new comment",
-			"key", val.ID,
-			"partition", partition,
-			"offset", offset,
-			"owner_id", val.OwnerID,
-			"user_id", val.CommentID)
-
 	}
 }
 
-func (s *OrderNotificationService) newSyncProducer(brokers []string) (sarama.SyncProducer, error) {
-	config := sarama.NewConfig()
-	config.Producer.Partitioner = sarama.NewHashPartitioner
-	config.Producer.RequiredAcks = sarama.WaitForAll
-	config.Producer.Idempotent = false
-	config.Producer.Retry.Max = 10
-	config.Producer.Retry.Backoff = 5 * time.Millisecond
-	config.Net.MaxOpenRequests = 1
-	config.Producer.CompressionLevel = sarama.CompressionLevelDefault
-	config.Producer.Compression = sarama.CompressionGZIP
-	config.Metadata.AllowAutoTopicCreation = false
-	config.Producer.Return.Successes = true
-	config.Producer.Return.Errors = true
-	syncProducer, err := sarama.NewSyncProducer(brokers, config)
-	if err != nil {
-		return nil, fmt.Errorf("NewSyncProducer failed: %w", err)
+// completeDigest enqueues the digest under a new trace linked to the traces of all aggregated
+// comments, the published digest continues this trace.
+func (s *NotificationScheduler) completeDigest(ctx context.Context, digest DigestNotification, ntfs []CommentNotification, next time.Time) error {
+	links := make([]oteltrace.Link, 0, len(ntfs))
+	for _, val := range ntfs {
+		spanCtx := oteltrace.SpanContextFromContext(trace.ContextWithTraceParent(ctx, val.TraceParent))
+		if spanCtx.IsValid() {
+			links = append(links, oteltrace.Link{SpanContext: spanCtx})
+		}
 	}
-	return syncProducer, nil
-}
-
-func (s *OrderNotificationService) MarkNotificationAsSend(ctx context.Context, notificationID int64) {
-	err := s.rep.MarkNotificationAsSend(ctx, notificationID)
-	if err != nil {
-		logger.Warnw(ctx, "can not mark notification as send", "err", err.Error())
+	digestCtx, span := trace.Tracer().Start(ctx, "comment digest", oteltrace.WithNewRoot(), oteltrace.WithLinks(links...))
+	defer span.End()
+	if err := s.rep.CompleteDigest(digestCtx, digest.RecipientID, &digest, next); err != nil {
+		span.RecordError(err)
+		return err
+	}
+	now := time.Now()
+	for _, val := range ntfs {
+		metrics.ObserveNotificationLatency(metrics.StagePublished, val.CreatedTS, now)
 	}
+	logger.Infow(digestCtx, "digest enqueued",
+		"key", digest.ID,
+		"recipient_id", digest.RecipientID,
+		"count", digest.Count)
+	return nil
 }
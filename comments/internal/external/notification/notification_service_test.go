@@ -0,0 +1,68 @@
+package notification
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type schedulerRepStub struct {
+	pending  []CommentNotification
+	owners   []model.NotificationPreferences
+	owned    map[int64][]CommentNotification
+	digests  map[int64]*DigestNotification
+	next     map[int64]time.Time
+	enqueued int
+}
+
+func (r *schedulerRepStub) EnqueueNotifications(_ context.Context) ([]CommentNotification, error) {
+	ntfs := r.pending
+	r.pending = nil
+	r.enqueued += len(ntfs)
+	return ntfs, nil
+}
+
+func (r *schedulerRepStub) ClaimDueDigests(_ context.Context, _ time.Time, _ time.Time) ([]model.NotificationPreferences, error) {
+	owners := r.owners
+	r.owners = nil
+	return owners, nil
+}
+
+func (r *schedulerRepStub) GetRecipientNotification(_ context.Context, recipientID int64) ([]CommentNotification, error) {
+	return r.owned[recipientID], nil
+}
+
+func (r *schedulerRepStub) CompleteDigest(_ context.Context, ownerID int64, digest *DigestNotification, next time.Time) error {
+	r.digests[ownerID] = digest
+	r.next[ownerID] = next
+	return nil
+}
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2025, 3, 14, 15, 9, 0, 0, time.UTC)
+	rep := &schedulerRepStub{
+		pending: []CommentNotification{{ID: 1, RecipientID: 7, CreatedTS: now}, {ID: 2, RecipientID: 8, CreatedTS: now}},
+		owners: []model.NotificationPreferences{
+			{OwnerID: 9, Mode: model.ModeDailyDigest},
+			{OwnerID: 10, Mode: model.ModeHourlyDigest},
+		},
+		owned: map[int64][]CommentNotification{
+			9: {{ID: 3, RecipientID: 9, CommentID: 5, ProductID: 2, CreatedTS: now}},
+		},
+		digests: map[int64]*DigestNotification{},
+		next:    map[int64]time.Time{},
+	}
+	scheduler := NewNotificationScheduler(rep, time.Second)
+
+	scheduler.Schedule(context.Background(), now)
+	require.Equal(t, 2, rep.enqueued, "Enqueued notifications mismatch")
+	require.NotNil(t, rep.digests[9], "Digest is not completed")
+	require.Equal(t, []int64{3}, rep.digests[9].NotificationIDs, "Digest notifications mismatch")
+	require.Equal(t, now.Add(24*time.Hour).Truncate(24*time.Hour), rep.next[9], "Next daily digest mismatch")
+	require.Contains(t, rep.digests, int64(10), "Empty digest is not completed")
+	require.Nil(t, rep.digests[10], "Empty digest is enqueued")
+	require.Equal(t, now.Truncate(time.Hour).Add(time.Hour), rep.next[10], "Next hourly digest mismatch")
+}
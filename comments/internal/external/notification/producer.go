@@ -0,0 +1,56 @@
+package notification
+
+import (
+	"example/comments/internal/app/config"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+type ProducerConfig struct {
+	KafkaConfig
+	// Idempotent enables the idempotent producer so broker retries do not duplicate notifications.
+	Idempotent bool
+	// TransactionalID enables Kafka transactions wrapping each outbox batch when not empty.
+	TransactionalID string
+}
+
+func (c ProducerConfig) Transactional() bool {
+	return c.TransactionalID != ""
+}
+
+func NewProducerConfig(conf *config.Config) ProducerConfig {
+	return ProducerConfig{
+		KafkaConfig:     NewKafkaConfig(conf),
+		Idempotent:      conf.KafkaConf.Idempotent,
+		TransactionalID: conf.KafkaConf.TransactionalID,
+	}
+}
+
+// NewSyncProducer creates an idempotent producer, transactional when conf has a transactional id.
+func NewSyncProducer(conf ProducerConfig) (sarama.SyncProducer, error) {
+	config, err := NewSaramaConfig(conf.KafkaConfig)
+	if err != nil {
+		return nil, err
+	}
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Idempotent = conf.Idempotent || conf.Transactional()
+	config.Producer.Retry.Max = 10
+	config.Producer.Retry.Backoff = 5 * time.Millisecond
+	config.Net.MaxOpenRequests = 1
+	config.Producer.CompressionLevel = sarama.CompressionLevelDefault
+	config.Producer.Compression = sarama.CompressionGZIP
+	config.Metadata.AllowAutoTopicCreation = false
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+	if conf.Transactional() {
+		config.Producer.Transaction.ID = conf.TransactionalID
+	}
+	syncProducer, err := sarama.NewSyncProducer(conf.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("NewSyncProducer failed: %w", err)
+	}
+	return syncProducer, nil
+}
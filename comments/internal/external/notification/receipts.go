@@ -0,0 +1,46 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/logger"
+	"example/comments/internal/outbox"
+
+	"github.com/IBM/sarama"
+)
+
+// ReceiptPublisher reports delivery receipts for the notifications delivered by this repo's notifier.
+type ReceiptPublisher struct {
+	topic string
+	prod  sarama.SyncProducer
+}
+
+// NewReceiptPublisher creates a non-transactional producer for receipts, receipts are
+// idempotent so the outbox transactional id is not used.
+func NewReceiptPublisher(conf ProducerConfig, topic string) (*ReceiptPublisher, error) {
+	conf.TransactionalID = ""
+	prod, err := NewSyncProducer(conf)
+	if err != nil {
+		return nil, err
+	}
+	return &ReceiptPublisher{
+		topic: topic,
+		prod:  prod,
+	}, nil
+}
+
+func (p *ReceiptPublisher) Publish(ctx context.Context, receipt DeliveryReceipt) error {
+	bytes, err := json.Marshal(receipt)
+	if err != nil {
+		return err
+	}
+	_, err = outbox.Send(ctx, p.prod, p.topic, "", bytes, nil)
+	if err != nil {
+		logger.Warnw(ctx, "can not send delivery receipt", "error", err.Error())
+	}
+	return err
+}
+
+func (p *ReceiptPublisher) Close() error {
+	return p.prod.Close()
+}
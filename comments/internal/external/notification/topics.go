@@ -0,0 +1,90 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"fmt"
+	"strconv"
+
+	"github.com/IBM/sarama"
+)
+
+var (
+	ErrTopicNotFound      = errors.New("kafka topic not found")
+	ErrTopicPartitionsLow = errors.New("kafka topic has less partitions than expected")
+)
+
+// TopicsConfig describes the topics the service expects. Partitions, replication factor and
+// retention are used when topics are created, partitions are also the minimum verified count.
+type TopicsConfig struct {
+	Verify            bool
+	Create            bool
+	Partitions        int32
+	ReplicationFactor int16
+	// RetentionMs is the retention.ms of created topics, the broker default is used when zero.
+	RetentionMs int64
+}
+
+// EnsureTopics checks that topics exist with at least the expected number of partitions and
+// creates the missing ones when conf.Create is set.
+func EnsureTopics(ctx context.Context, kafkaConf KafkaConfig, conf TopicsConfig, topics []string) error {
+	if !conf.Verify && !conf.Create {
+		return nil
+	}
+	saramaConf, err := NewSaramaConfig(kafkaConf)
+	if err != nil {
+		return err
+	}
+	admin, err := sarama.NewClusterAdmin(kafkaConf.Brokers, saramaConf)
+	if err != nil {
+		return fmt.Errorf("NewClusterAdmin failed: %w", err)
+	}
+	defer func() {
+		_ = admin.Close()
+	}()
+	existing, err := admin.ListTopics()
+	if err != nil {
+		return fmt.Errorf("list kafka topics failed: %w", err)
+	}
+	var errs []error
+	for _, topic := range topics {
+		detail, ok := existing[topic]
+		if !ok {
+			if !conf.Create {
+				errs = append(errs, fmt.Errorf("%w: %s, create it or enable kafka.topics.create", ErrTopicNotFound, topic))
+				continue
+			}
+			if err = createTopic(admin, conf, topic); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			logger.Infow(ctx, "kafka topic created",
+				"topic", topic,
+				"partitions", conf.Partitions,
+				"replication_factor", conf.ReplicationFactor)
+			continue
+		}
+		if detail.NumPartitions < conf.Partitions {
+			errs = append(errs, fmt.Errorf("%w: %s has %d, expected %d", ErrTopicPartitionsLow,
+				topic, detail.NumPartitions, conf.Partitions))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func createTopic(admin sarama.ClusterAdmin, conf TopicsConfig, topic string) error {
+	detail := &sarama.TopicDetail{
+		NumPartitions:     conf.Partitions,
+		ReplicationFactor: conf.ReplicationFactor,
+	}
+	if conf.RetentionMs > 0 {
+		retention := strconv.FormatInt(conf.RetentionMs, 10)
+		detail.ConfigEntries = map[string]*string{"retention.ms": &retention}
+	}
+	err := admin.CreateTopic(topic, detail, false)
+	if err != nil && !errors.Is(err, sarama.ErrTopicAlreadyExists) {
+		return fmt.Errorf("create kafka topic %s failed: %w", topic, err)
+	}
+	return nil
+}
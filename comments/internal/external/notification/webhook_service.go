@@ -0,0 +1,267 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"example/comments/internal/trace"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// Webhook request headers
+const (
+	EventHeader     = "X-Comments-Event"
+	TimestampHeader = "X-Comments-Timestamp"
+	SignatureHeader = "X-Comments-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+type WebhookRepository interface {
+	GetWebhookPendingNotification(_ context.Context) ([]CommentNotification, error)
+	ScheduleWebhookTasks(_ context.Context, ntf CommentNotification, event string, payload []byte) error
+	ClaimWebhookTasks(_ context.Context, leaseUntil time.Time) ([]model.WebhookTask, error)
+	SaveWebhookTaskResult(_ context.Context, task model.WebhookTask) error
+	RescheduleWebhookTasks(_ context.Context, ids []int64, at time.Time) error
+	SaveWebhookDelivery(_ context.Context, delivery model.WebhookDelivery) error
+}
+
+type WebhookConfig struct {
+	Timer       time.Duration
+	Timeout     time.Duration
+	Backoff     time.Duration
+	MaxAttempts int
+	// Lease hides claimed tasks from other replicas, it must cover the delivery of a whole batch
+	Lease time.Duration
+	// AllowPrivateNetworks permits connections to loopback and private addresses
+	AllowPrivateNetworks bool
+}
+
+// WebhookService posts outbox notifications to the endpoints registered by product owners.
+// It drains the outbox independently of the Kafka publisher, so slow endpoints do not delay Kafka.
+// Every notification becomes a task per endpoint. Endpoints are served concurrently, and a failed
+// attempt reschedules its task with backoff instead of waiting, so a dead endpoint only delays itself.
+type WebhookService struct {
+	rep    WebhookRepository
+	client *http.Client
+	conf   WebhookConfig
+}
+
+func StartWebhookService(ctx context.Context, rep WebhookRepository, conf WebhookConfig) {
+	webhookService := &WebhookService{
+		rep:    rep,
+		client: NewWebhookClient(conf),
+		conf:   conf,
+	}
+	go func(s *WebhookService) {
+		ticker := time.NewTicker(s.conf.Timer)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Infow(ctx, "webhook service context closed")
+				return
+			case <-ticker.C:
+				s.SendWebhooks(ctx)
+			}
+		}
+	}(webhookService)
+}
+
+// NewWebhookClient returns a client that refuses to connect to internal addresses, the check
+// runs on the resolved address, so host names pointing to internal networks are refused too.
+func NewWebhookClient(conf WebhookConfig) *http.Client {
+	dialer := &net.Dialer{Timeout: conf.Timeout}
+	if !conf.AllowPrivateNetworks {
+		dialer.Control = func(_, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+			if !model.IsPublicAddr(addrPort.Addr()) {
+				return fmt.Errorf("%w: address %s is not public", model.ErrInvalidWebhookURL, addrPort.Addr())
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// the proxy would dial on our behalf and bypass the address check
+	transport.Proxy = nil
+	return &http.Client{
+		Timeout:   conf.Timeout,
+		Transport: transport,
+	}
+}
+
+func (s *WebhookService) SendWebhooks(ctx context.Context) {
+	s.schedule(ctx)
+	tasks, err := s.rep.ClaimWebhookTasks(ctx, time.Now().Add(s.conf.Lease))
+	if err != nil {
+		logger.Warnw(ctx, "can not claim webhook tasks", "error", err.Error())
+		return
+	}
+	endpoints := make(map[int64][]model.WebhookTask)
+	for _, task := range tasks {
+		endpoints[task.Webhook.ID] = append(endpoints[task.Webhook.ID], task)
+	}
+	wg := sync.WaitGroup{}
+	for _, endpointTasks := range endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.deliverEndpoint(ctx, endpointTasks)
+		}()
+	}
+	wg.Wait()
+}
+
+// schedule turns the pending notifications into tasks of the recipient endpoints.
+func (s *WebhookService) schedule(ctx context.Context) {
+	ntfs, err := s.rep.GetWebhookPendingNotification(ctx)
+	if err != nil {
+		logger.Warnw(ctx, "can not get webhook notifications", "error", err.Error())
+		return
+	}
+	for _, ntf := range ntfs {
+		payload, err := json.Marshal(ntf)
+		if err != nil {
+			logger.Warnw(ctx, "marshal webhook payload failed", "error", err.Error())
+			continue
+		}
+		if err = s.rep.ScheduleWebhookTasks(ctx, ntf, EventForReason(ntf.Reason), payload); err != nil {
+			logger.Warnw(ctx, "can not schedule webhook tasks", "error", err.Error(), "notification_id", ntf.ID)
+			return
+		}
+	}
+}
+
+// deliverEndpoint attempts the tasks of one endpoint in order. After a failure the rest of the
+// tasks wait for the retry of the failed one rather than time out one by one.
+func (s *WebhookService) deliverEndpoint(ctx context.Context, tasks []model.WebhookTask) {
+	for i, task := range tasks {
+		if ctx.Err() != nil {
+			return
+		}
+		task = s.deliver(ctx, task)
+		if err := s.rep.SaveWebhookTaskResult(ctx, task); err != nil {
+			logger.Warnw(ctx, "can not save webhook task", "error", err.Error(), "task_id", task.ID)
+		}
+		if task.Status != model.WebhookTaskNew || i == len(tasks)-1 {
+			continue
+		}
+		ids := make([]int64, 0, len(tasks)-i-1)
+		for _, rest := range tasks[i+1:] {
+			ids = append(ids, rest.ID)
+		}
+		if err := s.rep.RescheduleWebhookTasks(ctx, ids, task.NextAttemptTS); err != nil {
+			logger.Warnw(ctx, "can not reschedule webhook tasks", "error", err.Error(), "webhook_id", task.Webhook.ID)
+		}
+		return
+	}
+}
+
+// deliver makes one attempt, logs it and returns the task with the outcome: done, rescheduled
+// with exponential backoff, or failed once the attempts run out.
+func (s *WebhookService) deliver(ctx context.Context, task model.WebhookTask) model.WebhookTask {
+	ctx, span := trace.Tracer().Start(
+		trace.ContextWithTraceParent(ctx, task.TraceParent),
+		"webhook deliver",
+		oteltrace.WithSpanKind(oteltrace.SpanKindClient),
+	)
+	defer span.End()
+
+	task.Attempt++
+	statusCode, err := s.post(ctx, task)
+	delivery := model.WebhookDelivery{
+		WebhookID:      task.Webhook.ID,
+		NotificationID: task.NotificationID,
+		Attempt:        task.Attempt,
+		StatusCode:     int32(statusCode),
+		Success:        err == nil,
+	}
+	if err != nil {
+		delivery.Error = err.Error()
+	}
+	if saveErr := s.rep.SaveWebhookDelivery(ctx, delivery); saveErr != nil {
+		logger.Warnw(ctx, "can not save webhook delivery", "error", saveErr.Error())
+	}
+	task.NextAttemptTS = time.Now()
+	if err == nil {
+		task.Status = model.WebhookTaskDone
+		logger.Infow(ctx, "webhook delivered", "webhook_id", task.Webhook.ID, "notification_id", task.NotificationID,
+			"attempt", task.Attempt)
+		return task
+	}
+	span.RecordError(err)
+	if int(task.Attempt) >= s.conf.MaxAttempts {
+		task.Status = model.WebhookTaskFailed
+		span.SetStatus(codes.Error, "webhook delivery failed")
+		logger.Warnw(ctx, "webhook delivery failed", "webhook_id", task.Webhook.ID, "notification_id", task.NotificationID,
+			"attempts", task.Attempt)
+		return task
+	}
+	task.Status = model.WebhookTaskNew
+	task.NextAttemptTS = task.NextAttemptTS.Add(s.conf.Backoff << (task.Attempt - 1))
+	logger.Warnw(ctx, "webhook attempt failed", "error", err.Error(), "webhook_id", task.Webhook.ID,
+		"notification_id", task.NotificationID, "attempt", task.Attempt, "next_attempt_at", task.NextAttemptTS)
+	return task
+}
+
+func (s *WebhookService) post(ctx context.Context, task model.WebhookTask) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, task.Webhook.URL, bytes.NewReader(task.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("create webhook request failed: %w", err)
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, task.Event)
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, SignatureHeaderValue(task.Webhook.Secret, timestamp, task.Payload))
+	trace.Propagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// SignatureHeaderValue returns the SignatureHeader value of a webhook request.
+func SignatureHeaderValue(secret string, timestamp string, payload []byte) string {
+	return signaturePrefix + Sign(secret, timestamp, payload)
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed with the webhook secret.
+// Receivers recompute it to verify both the payload and the timestamp header.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
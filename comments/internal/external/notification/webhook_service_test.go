@@ -0,0 +1,168 @@
+package notification
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type webhookRepositoryStub struct {
+	mu          sync.Mutex
+	pending     []CommentNotification
+	scheduled   map[int64]string
+	tasks       []model.WebhookTask
+	results     map[int64]model.WebhookTask
+	rescheduled map[int64]time.Time
+	deliveries  []model.WebhookDelivery
+}
+
+func newWebhookRepositoryStub(tasks ...model.WebhookTask) *webhookRepositoryStub {
+	return &webhookRepositoryStub{
+		scheduled:   make(map[int64]string),
+		tasks:       tasks,
+		results:     make(map[int64]model.WebhookTask),
+		rescheduled: make(map[int64]time.Time),
+	}
+}
+
+func (r *webhookRepositoryStub) GetWebhookPendingNotification(_ context.Context) ([]CommentNotification, error) {
+	return r.pending, nil
+}
+
+func (r *webhookRepositoryStub) ScheduleWebhookTasks(_ context.Context, ntf CommentNotification, event string, _ []byte) error {
+	r.scheduled[ntf.ID] = event
+	return nil
+}
+
+func (r *webhookRepositoryStub) ClaimWebhookTasks(_ context.Context, _ time.Time) ([]model.WebhookTask, error) {
+	return r.tasks, nil
+}
+
+func (r *webhookRepositoryStub) SaveWebhookTaskResult(_ context.Context, task model.WebhookTask) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results[task.ID] = task
+	return nil
+}
+
+func (r *webhookRepositoryStub) RescheduleWebhookTasks(_ context.Context, ids []int64, at time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, id := range ids {
+		r.rescheduled[id] = at
+	}
+	return nil
+}
+
+func (r *webhookRepositoryStub) SaveWebhookDelivery(_ context.Context, delivery model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.deliveries = append(r.deliveries, delivery)
+	return nil
+}
+
+func newTestWebhookService(rep WebhookRepository, client *http.Client) *WebhookService {
+	return &WebhookService{
+		rep:    rep,
+		client: client,
+		conf: WebhookConfig{
+			Timeout:     time.Second,
+			Backoff:     time.Minute,
+			MaxAttempts: 3,
+		},
+	}
+}
+
+func TestSendWebhooksSchedulesByReason(t *testing.T) {
+	rep := newWebhookRepositoryStub()
+	rep.pending = []CommentNotification{
+		{ID: 1, RecipientID: 173, Reason: model.ReasonNewComment},
+		{ID: 2, RecipientID: 173, Reason: model.ReasonReply},
+		{ID: 3, RecipientID: 173, Reason: model.ReasonMention},
+		{ID: 4, RecipientID: 173, Reason: model.ReasonOwnerAnswer},
+	}
+	newTestWebhookService(rep, http.DefaultClient).SendWebhooks(context.Background())
+	require.Equal(t, map[int64]string{
+		1: EventCommentCreated,
+		2: EventCommentReply,
+		3: EventCommentMention,
+		4: EventCommentOwnerAnswer,
+	}, rep.scheduled, "Scheduled events mismatch")
+}
+
+func TestSendWebhooksReschedulesFailedEndpoint(t *testing.T) {
+	const secret = "0123456789abcdef"
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		expected := signaturePrefix + Sign(secret, r.Header.Get(TimestampHeader), body)
+		assert.Equal(t, expected, r.Header.Get(SignatureHeader), "Signature mismatch")
+		assert.Equal(t, EventCommentReply, r.Header.Get(EventHeader), "Event mismatch")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer healthy.Close()
+	deadCalls := 0
+	dead := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		deadCalls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer dead.Close()
+
+	healthyWebhook := model.Webhook{ID: 7, URL: healthy.URL, Secret: secret}
+	deadWebhook := model.Webhook{ID: 8, URL: dead.URL, Secret: secret}
+	rep := newWebhookRepositoryStub(
+		model.WebhookTask{ID: 1, Webhook: deadWebhook, NotificationID: 11, Event: EventCommentCreated, Payload: []byte(`{"id":11}`)},
+		model.WebhookTask{ID: 2, Webhook: healthyWebhook, NotificationID: 12, Event: EventCommentReply, Payload: []byte(`{"id":12}`)},
+		model.WebhookTask{ID: 3, Webhook: deadWebhook, NotificationID: 12, Event: EventCommentReply, Payload: []byte(`{"id":12}`)},
+	)
+	before := time.Now()
+	newTestWebhookService(rep, http.DefaultClient).SendWebhooks(context.Background())
+
+	require.Equal(t, model.WebhookTaskDone, rep.results[2].Status, "Healthy endpoint task status mismatch")
+	require.Equal(t, int32(1), rep.results[2].Attempt, "Healthy endpoint attempt mismatch")
+
+	require.Equal(t, 1, deadCalls, "Dead endpoint is attempted after a failure")
+	failed := rep.results[1]
+	require.Equal(t, model.WebhookTaskNew, failed.Status, "Failed task is not rescheduled")
+	require.Equal(t, int32(1), failed.Attempt, "Failed task attempt mismatch")
+	require.False(t, failed.NextAttemptTS.Before(before.Add(time.Minute)), "Backoff is not applied")
+	_, attempted := rep.results[3]
+	require.False(t, attempted, "Task after a failure is attempted")
+	require.Equal(t, failed.NextAttemptTS, rep.rescheduled[3], "Task after a failure is not rescheduled")
+	require.Len(t, rep.deliveries, 2, "Deliveries log mismatch")
+}
+
+func TestSendWebhooksFailsAfterAttempts(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+	rep := newWebhookRepositoryStub(model.WebhookTask{ID: 1, Webhook: model.Webhook{ID: 7, URL: srv.URL},
+		NotificationID: 11, Event: EventCommentCreated, Attempt: 2})
+	newTestWebhookService(rep, http.DefaultClient).SendWebhooks(context.Background())
+
+	require.Equal(t, model.WebhookTaskFailed, rep.results[1].Status, "Exhausted task status mismatch")
+	require.Equal(t, int32(3), rep.deliveries[0].Attempt, "Attempt mismatch")
+	require.Equal(t, int32(http.StatusInternalServerError), rep.deliveries[0].StatusCode, "Status code mismatch")
+}
+
+func TestWebhookClientRefusesPrivateAddresses(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	_, err := NewWebhookClient(WebhookConfig{Timeout: time.Second}).Post(srv.URL, "application/json", nil)
+	require.ErrorIs(t, err, model.ErrInvalidWebhookURL, "Loopback address is dialed")
+
+	resp, err := NewWebhookClient(WebhookConfig{Timeout: time.Second, AllowPrivateNetworks: true}).Post(srv.URL, "application/json", nil)
+	require.NoError(t, err, "Private networks are not allowed")
+	require.NoError(t, resp.Body.Close())
+}
@@ -3,34 +3,64 @@ package products
 import (
 	"context"
 	"example/comments/internal/external/api/v1"
-	mwc "example/comments/internal/external/middlewares"
-	"example/comments/internal/logger"
+	"example/comments/internal/external/batch"
+	"example/comments/internal/external/cache"
+	"example/comments/internal/external/client"
+
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type ProductService struct {
-	Client external.ProductsClient
+	Client  external.ProductsClient
+	batcher *batch.Batcher[int64, int64]
+	cache   *cache.Cache[int64, int64]
+	conn    *grpc.ClientConn
 }
 
-func NewProductsService(ctx context.Context, productsAddress string) (*ProductService, error) {
-	logger.Infow(ctx, "start products client", "address", productsAddress)
-	conn, err := grpc.NewClient(productsAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithChainUnaryInterceptor(
-			mwc.Logger,
-			mwc.Tracer,
-			mwc.Validate))
+// NewProductsService creates the client, with a non-zero batch window concurrent owner
+// lookups are coalesced into GetOwners calls, with a non-zero cache TTL the owners are cached.
+func NewProductsService(ctx context.Context, conf client.Config) (*ProductService, error) {
+	// all the methods only read, so they are safe to retry
+	conn, err := client.Dial(ctx, "products", conf, external.Products_ServiceDesc, "GetOwner", "GetOwners")
 	if err != nil {
-		logger.Errorw(ctx, "products service unavailable", "error", err.Error())
 		return nil, err
 	}
-	productsClient := external.NewProductsClient(conn)
-	return &ProductService{
-		Client: productsClient,
-	}, nil
+	s := &ProductService{
+		conn:   conn,
+		Client: external.NewProductsClient(conn),
+	}
+	if conf.Batch.Window > 0 {
+		s.batcher = batch.New(s.GetProductOwners, conf.Batch)
+	}
+	if conf.Cache.TTL > 0 {
+		s.cache = cache.New("product_owners", s.getProductOwner, func(ownerID int64) bool { return ownerID != 0 }, conf.Cache)
+	}
+	return s, nil
 }
 
 func (s *ProductService) GetProductOwner(ctx context.Context, productID int64) (int64, error) {
+	if s.cache != nil {
+		return s.cache.Get(ctx, productID)
+	}
+	return s.getProductOwner(ctx, productID)
+}
+
+// Ready reports an error unless the connection to the service can be used.
+func (s *ProductService) Ready(_ context.Context) error {
+	return client.Ready(s.conn)
+}
+
+// InvalidateProduct drops the cached owner, it is called when the product changes.
+func (s *ProductService) InvalidateProduct(productID int64) {
+	if s.cache != nil {
+		s.cache.Invalidate(productID)
+	}
+}
+
+func (s *ProductService) getProductOwner(ctx context.Context, productID int64) (int64, error) {
+	if s.batcher != nil {
+		return s.batcher.Get(ctx, productID)
+	}
 	req := &external.GetOwnerRequest{
 		ProductID: productID,
 	}
@@ -40,3 +70,15 @@ func (s *ProductService) GetProductOwner(ctx context.Context, productID int64) (
 	}
 	return res.OwnerID, nil
 }
+
+// GetProductOwners returns the owners of the products, 0 for a product that does not exist.
+func (s *ProductService) GetProductOwners(ctx context.Context, productIDs []int64) (map[int64]int64, error) {
+	req := &external.GetOwnersRequest{
+		ProductIDs: productIDs,
+	}
+	res, err := s.Client.GetOwners(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Owners, nil
+}
@@ -3,34 +3,69 @@ package users
 import (
 	"context"
 	"example/comments/internal/external/api/v1"
-	mwc "example/comments/internal/external/middlewares"
-	"example/comments/internal/logger"
+	"example/comments/internal/external/batch"
+	"example/comments/internal/external/cache"
+	"example/comments/internal/external/client"
+	"example/comments/internal/model"
+
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+// maxBatch is the limit of ids in one batch request to the users service.
+const maxBatch = 1000
+
 type UserService struct {
-	Client external.UsersClient
+	Client  external.UsersClient
+	batcher *batch.Batcher[int64, bool]
+	cache   *cache.Cache[int64, bool]
+	conn    *grpc.ClientConn
 }
 
-func NewUsersService(ctx context.Context, usersAddress string) (*UserService, error) {
-	logger.Infow(ctx, "start users client", "address", usersAddress)
-	conn, err := grpc.NewClient(usersAddress, grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithChainUnaryInterceptor(
-			mwc.Logger,
-			mwc.Tracer,
-			mwc.Validate))
+// NewUsersService creates the client, with a non-zero batch window concurrent user checks
+// are coalesced into CheckUserIDs calls, with a non-zero cache TTL the checks are cached.
+func NewUsersService(ctx context.Context, conf client.Config) (*UserService, error) {
+	// all the methods only read, so they are safe to retry
+	conn, err := client.Dial(ctx, "users", conf, external.Users_ServiceDesc,
+		"CheckUserID", "CheckUserIDs", "GetUserProfiles")
 	if err != nil {
-		logger.Errorw(ctx, "users service unavailable", "error", err.Error())
 		return nil, err
 	}
-	usersClient := external.NewUsersClient(conn)
-	return &UserService{
-		Client: usersClient,
-	}, nil
+	s := &UserService{
+		conn:   conn,
+		Client: external.NewUsersClient(conn),
+	}
+	if conf.Batch.Window > 0 {
+		s.batcher = batch.New(s.CheckUserIDs, conf.Batch)
+	}
+	if conf.Cache.TTL > 0 {
+		s.cache = cache.New("user_checks", s.checkUserID, func(isCorrect bool) bool { return isCorrect }, conf.Cache)
+	}
+	return s, nil
 }
 
 func (s *UserService) CheckUserID(ctx context.Context, userID int64) (bool, error) {
+	if s.cache != nil {
+		return s.cache.Get(ctx, userID)
+	}
+	return s.checkUserID(ctx, userID)
+}
+
+// Ready reports an error unless the connection to the service can be used.
+func (s *UserService) Ready(_ context.Context) error {
+	return client.Ready(s.conn)
+}
+
+// InvalidateUser drops the cached check, it is called when the user is banned or unbanned.
+func (s *UserService) InvalidateUser(userID int64) {
+	if s.cache != nil {
+		s.cache.Invalidate(userID)
+	}
+}
+
+func (s *UserService) checkUserID(ctx context.Context, userID int64) (bool, error) {
+	if s.batcher != nil {
+		return s.batcher.Get(ctx, userID)
+	}
 	req := &external.CheckUserIDRequest{
 		UserID: userID,
 	}
@@ -40,3 +75,37 @@ func (s *UserService) CheckUserID(ctx context.Context, userID int64) (bool, erro
 	}
 	return res.IsCorrect, err
 }
+
+// CheckUserIDs reports for every user whether it exists and is not banned.
+func (s *UserService) CheckUserIDs(ctx context.Context, userIDs []int64) (map[int64]bool, error) {
+	req := &external.CheckUserIDsRequest{
+		UserIDs: userIDs,
+	}
+	res, err := s.Client.CheckUserIDs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return res.IsCorrect, nil
+}
+
+// GetUserProfiles returns the profiles of the existing users, unknown users are absent.
+func (s *UserService) GetUserProfiles(ctx context.Context, userIDs []int64) (map[int64]model.UserProfile, error) {
+	profiles := make(map[int64]model.UserProfile, len(userIDs))
+	for start := 0; start < len(userIDs); start += maxBatch {
+		req := &external.GetUserProfilesRequest{
+			UserIDs: userIDs[start:min(start+maxBatch, len(userIDs))],
+		}
+		res, err := s.Client.GetUserProfiles(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for userID, profile := range res.Profiles {
+			profiles[userID] = model.UserProfile{
+				UserID:      userID,
+				DisplayName: profile.DisplayName,
+				AvatarURL:   profile.AvatarURL,
+			}
+		}
+	}
+	return profiles, nil
+}
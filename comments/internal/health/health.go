@@ -0,0 +1,143 @@
+// Package health collects the readiness of the components of a process.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// checkTimeout bounds a check run for a readiness request.
+const checkTimeout = time.Second
+
+// Component statuses
+const (
+	StatusOK    = "ok"
+	StatusError = "error"
+)
+
+// Process statuses
+const (
+	StatusReady = "ready"
+	// StatusDegraded means only non-critical components failed, the process is still ready
+	StatusDegraded = "degraded"
+	StatusNotReady = "not_ready"
+)
+
+type check struct {
+	critical bool
+	run      func(ctx context.Context) error
+}
+
+// Registry keeps the last reported error of each component and the checks run on request.
+// A component without an error is ready.
+type Registry struct {
+	mu       sync.RWMutex
+	reported map[string]error
+	checks   map[string]check
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		reported: make(map[string]error),
+		checks:   make(map[string]check),
+	}
+}
+
+// Set reports the state of component, nil err marks it as ready. Reported components are critical.
+func (r *Registry) Set(component string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reported[component] = err
+}
+
+// AddCheck adds the component checked on every readiness request. A failed non-critical
+// component is shown in the report, but the process stays ready in the degraded state.
+func (r *Registry) AddCheck(component string, critical bool, run func(ctx context.Context) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.checks[component] = check{critical: critical, run: run}
+}
+
+// ComponentReport is the state of one component.
+type ComponentReport struct {
+	Status   string `json:"status"`
+	Error    string `json:"error,omitempty"`
+	Critical bool   `json:"critical"`
+}
+
+// Report is the state of the process and of its components.
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentReport `json:"components"`
+}
+
+// Ready reports whether the process can serve requests.
+func (rep Report) Ready() bool {
+	return rep.Status != StatusNotReady
+}
+
+// Check runs the checks and returns them together with the reported components.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	reported := make(map[string]error, len(r.reported))
+	for component, err := range r.reported {
+		reported[component] = err
+	}
+	checks := make(map[string]check, len(r.checks))
+	for component, c := range r.checks {
+		checks[component] = c
+	}
+	r.mu.RUnlock()
+
+	rep := Report{
+		Status:     StatusReady,
+		Components: make(map[string]ComponentReport, len(reported)+len(checks)),
+	}
+	add := func(component string, critical bool, err error) {
+		res := ComponentReport{Status: StatusOK, Critical: critical}
+		if err != nil {
+			res.Status = StatusError
+			res.Error = err.Error()
+			switch {
+			case critical:
+				rep.Status = StatusNotReady
+			case rep.Status == StatusReady:
+				rep.Status = StatusDegraded
+			}
+		}
+		rep.Components[component] = res
+	}
+	for component, err := range reported {
+		add(component, true, err)
+	}
+	for component, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		add(component, c.critical, c.run(checkCtx))
+		cancel()
+	}
+	return rep
+}
+
+// ReadyHandler responds with the report, the status is 200 when the process is ready or
+// degraded and 503 otherwise.
+func (r *Registry) ReadyHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rep := r.Check(req.Context())
+		w.Header().Set("Content-Type", "application/json")
+		if !rep.Ready() {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_ = json.NewEncoder(w).Encode(rep)
+	})
+}
+
+// LiveHandler responds 200 while the process serves HTTP, dependencies do not affect liveness.
+func LiveHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"ok"}` + "\n"))
+	})
+}
@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadyHandler(t *testing.T) {
+	r := NewRegistry()
+	r.Set("kafka_topics", nil)
+	rec := httptest.NewRecorder()
+	r.ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusOK, rec.Code, "Ready status mismatch")
+
+	r.Set("producer", errors.New("kafka is not available"))
+	r.Set("kafka_topics", errors.New("topic comments.create-comment not found"))
+	rec = httptest.NewRecorder()
+	r.ReadyHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	require.Equal(t, http.StatusServiceUnavailable, rec.Code, "Not ready status mismatch")
+	rep := Report{}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &rep), "Body is not a report")
+	require.Equal(t, StatusNotReady, rep.Status, "Report status mismatch")
+	require.Equal(t, map[string]ComponentReport{
+		"kafka_topics": {Status: StatusError, Error: "topic comments.create-comment not found", Critical: true},
+		"producer":     {Status: StatusError, Error: "kafka is not available", Critical: true},
+	}, rep.Components, "Components mismatch")
+}
+
+func TestCheckDegraded(t *testing.T) {
+	r := NewRegistry()
+	r.AddCheck("postgres", true, func(_ context.Context) error {
+		return nil
+	})
+	r.AddCheck("external_users", false, func(_ context.Context) error {
+		return errors.New("connection is transient_failure")
+	})
+
+	rep := r.Check(context.Background())
+	require.Equal(t, StatusDegraded, rep.Status, "Report status mismatch")
+	require.True(t, rep.Ready(), "Degraded process is not ready")
+	require.Equal(t, StatusOK, rep.Components["postgres"].Status, "Postgres status mismatch")
+	require.Equal(t, "connection is transient_failure", rep.Components["external_users"].Error, "Error mismatch")
+
+	r.AddCheck("postgres", true, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+	rep = r.Check(context.Background())
+	require.False(t, rep.Ready(), "Process without postgres is ready")
+}
+
+func TestLiveHandler(t *testing.T) {
+	rec := httptest.NewRecorder()
+	LiveHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, rec.Code, "Live status mismatch")
+}
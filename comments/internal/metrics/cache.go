@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Results of client cache lookups
+const (
+	CacheHit         = "hit"
+	CacheNegativeHit = "negative_hit"
+	CacheMiss        = "miss"
+)
+
+var cacheLookups = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "client_cache_lookups_total",
+	Help:      "Lookups in the caches of the external service clients.",
+}, []string{"cache", "result"})
+
+var cacheEvictions = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "client_cache_evictions_total",
+	Help:      "Entries evicted from the client caches because of the size bound.",
+}, []string{"cache"})
+
+var cacheEntries = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "comments",
+	Name:      "client_cache_entries",
+	Help:      "Entries in the client caches.",
+}, []string{"cache"})
+
+func IncCacheLookups(cache string, result string) {
+	cacheLookups.WithLabelValues(cache, result).Inc()
+}
+
+func IncCacheEvictions(cache string) {
+	cacheEvictions.WithLabelValues(cache).Inc()
+}
+
+func SetCacheEntries(cache string, entries int) {
+	cacheEntries.WithLabelValues(cache).Set(float64(entries))
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var circuitState = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "comments",
+	Name:      "client_circuit_state",
+	Help:      "Circuit breaker state of the external service clients, 1 for the current state.",
+}, []string{"client", "state"})
+
+var circuitStates = []string{"closed", "half_open", "open"}
+
+func SetCircuitState(client string, state string) {
+	for _, val := range circuitStates {
+		value := 0.0
+		if val == state {
+			value = 1
+		}
+		circuitState.WithLabelValues(client, val).Set(value)
+	}
+}
+
+var endpointEjections = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "client_endpoint_ejections_total",
+	Help:      "Endpoints of the external services ejected from balancing after consecutive failures.",
+}, []string{"client"})
+
+func IncEndpointEjections(client string) {
+	endpointEjections.WithLabelValues(client).Inc()
+}
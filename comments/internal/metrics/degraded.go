@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Outcomes of comments accepted in degraded mode
+const (
+	DegradedAccepted  = "accepted"
+	DegradedPublished = "published"
+	DegradedRejected  = "rejected"
+	DegradedPostponed = "postponed"
+)
+
+var degradedComments = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "degraded_comments_total",
+	Help:      "Comments accepted pending validation and the results of their validation attempts.",
+}, []string{"result"})
+
+func IncDegradedComments(result string) {
+	degradedComments.WithLabelValues(result).Inc()
+}
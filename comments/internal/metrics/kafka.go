@@ -0,0 +1,62 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Results of producer connect attempts
+const (
+	ResultSuccess = "success"
+	ResultError   = "error"
+)
+
+var producerUp = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Namespace: "comments",
+	Name:      "kafka_producer_up",
+	Help:      "Whether the supervised Kafka producer is connected.",
+}, []string{"producer"})
+
+var producerConnects = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "kafka_producer_connect_attempts_total",
+	Help:      "Connect attempts of supervised Kafka producers.",
+}, []string{"producer", "result"})
+
+func SetProducerUp(producer string, up bool) {
+	value := 0.0
+	if up {
+		value = 1
+	}
+	producerUp.WithLabelValues(producer).Set(value)
+}
+
+func IncProducerConnects(producer string, result string) {
+	producerConnects.WithLabelValues(producer, result).Inc()
+}
+
+// Reasons a consumer gives up on a message
+const (
+	ConsumerFailurePermanent = "permanent"
+	ConsumerFailureExhausted = "exhausted"
+)
+
+var consumerFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "kafka_consumer_failed_messages_total",
+	Help:      "Messages the consumer groups gave up on.",
+}, []string{"group", "reason"})
+
+var consumerDeadLetters = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "comments",
+	Name:      "kafka_consumer_dead_letters_total",
+	Help:      "Messages the consumer groups moved to the dead letter topic.",
+}, []string{"group"})
+
+func IncConsumerFailures(group string, reason string) {
+	consumerFailures.WithLabelValues(group, reason).Inc()
+}
+
+func IncConsumerDeadLetters(group string) {
+	consumerDeadLetters.WithLabelValues(group).Inc()
+}
@@ -0,0 +1,27 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Notification stages measured from the comment creation
+const (
+	StagePublished = "published"
+	StageDelivered = "delivered"
+	StageRead      = "read"
+)
+
+var notificationLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: "comments",
+	Name:      "notification_latency_seconds",
+	Help:      "Time from comment creation to the notification reaching the stage.",
+	Buckets:   prometheus.ExponentialBuckets(0.05, 2, 18),
+}, []string{"stage"})
+
+// ObserveNotificationLatency records the time between the comment creation and the stage.
+func ObserveNotificationLatency(stage string, createdTS time.Time, at time.Time) {
+	notificationLatency.WithLabelValues(stage).Observe(at.Sub(createdTS).Seconds())
+}
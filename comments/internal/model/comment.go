@@ -2,11 +2,51 @@ package model
 
 import "time"
 
+// Notification reasons
+const (
+	ReasonNewComment  = "new_comment"
+	ReasonReply       = "reply"
+	ReasonOwnerAnswer = "owner_answer"
+	ReasonMention     = "mention"
+	// ReasonCommentRejected notifies the author that the comment accepted in degraded mode
+	// did not pass the validation
+	ReasonCommentRejected = "comment_rejected"
+)
+
+// Comment statuses
+const (
+	CommentPublished = "published"
+	// CommentPendingValidation is a comment accepted while the users or products service was
+	// unavailable, it is hidden until the validator checks it
+	CommentPendingValidation = "pending_validation"
+	CommentRejected          = "rejected"
+)
+
+// Reasons a pending comment is rejected for
+const (
+	RejectIncorrectUser   = "incorrect_user"
+	RejectProductNotFound = "product_not_found"
+	RejectParentNotFound  = "parent_not_found"
+)
+
 type Comment struct {
 	ID             int64
 	ProductID      int64
 	ProductOwnerID int64
 	UserID         int64
+	ParentID       int64
 	Text           string
 	Ts             time.Time
+	Recipients     []Recipient
+	Status         string
+	// ValidationAttempts is the number of postponed validations of a pending comment
+	ValidationAttempts int
+	// Author is set only when the comments are listed with the author profiles
+	Author *UserProfile
+}
+
+// Recipient is a user notified about a new comment.
+type Recipient struct {
+	UserID int64
+	Reason string
 }
@@ -0,0 +1,20 @@
+package model
+
+import "time"
+
+// TopicCommentEvents is the logical outbox topic of comment lifecycle events.
+const TopicCommentEvents = "comment-events"
+
+// Comment event types
+const (
+	EventCommentCreated = "comment.created"
+)
+
+// CommentEvent is published through the outbox on comment changes, keyed by product.
+type CommentEvent struct {
+	CommentID int64     `json:"comment_id"`
+	ProductID int64     `json:"product_id"`
+	UserID    int64     `json:"user_id"`
+	ParentID  int64     `json:"parent_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -7,3 +7,21 @@ var ErrIncorrectUserID = errors.New("userID is incorrect")
 var ErrUserServiceUnavailable = errors.New("user service unavailable")
 var ErrProductOwnerNotFound = errors.New("product owner not found")
 var ErrProductServiceUnavailable = errors.New("product service unavailable")
+var ErrParentCommentNotFound = errors.New("parent comment not found")
+
+// Repository errors
+var ErrCommentNotFound = errors.New("comment not found")
+
+// Notifier errors
+var ErrNotificationChannelNotFound = errors.New("notification channel not found")
+var ErrInvalidNotificationChannel = errors.New("invalid notification channel")
+
+// Webhook errors
+var ErrInvalidWebhookURL = errors.New("invalid webhook url")
+
+// Notification preferences errors
+var ErrNotificationPreferencesNotFound = errors.New("notification preferences not found")
+var ErrInvalidTimezone = errors.New("invalid timezone")
+
+// Outbox admin errors
+var ErrUnknownOutboxTopic = errors.New("unknown outbox topic")
@@ -0,0 +1,17 @@
+package model
+
+// Notification delivery channels
+const (
+	ChannelLog     = "log"
+	ChannelEmail   = "email"
+	ChannelWebhook = "webhook"
+)
+
+type NotificationChannel struct {
+	OwnerID int64
+	Kind    string
+	Address string
+	Locale  string
+	// Secret signs the requests of the webhook channel
+	Secret string
+}
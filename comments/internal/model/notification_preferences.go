@@ -0,0 +1,81 @@
+package model
+
+import (
+	"fmt"
+	"time"
+	// the service image has no zoneinfo, owner timezones are resolved from the embedded copy
+	_ "time/tzdata"
+)
+
+// Notification delivery modes
+const (
+	ModeImmediate    = "immediate"
+	ModeHourlyDigest = "hourly"
+	ModeDailyDigest  = "daily"
+)
+
+// QuietHours is an interval [Start, End) in hours of the owner timezone, it may wrap over midnight.
+type QuietHours struct {
+	Start int
+	End   int
+}
+
+// Contains reports whether the hour falls into the quiet interval.
+func (q QuietHours) Contains(hour int) bool {
+	if q.Start <= q.End {
+		return hour >= q.Start && hour < q.End
+	}
+	return hour >= q.Start || hour < q.End
+}
+
+type NotificationPreferences struct {
+	OwnerID         int64
+	Mode            string
+	MutedProductIDs []int64
+	QuietHours      *QuietHours
+	// Timezone is an IANA name, quiet hours and digest periods follow it. Empty means UTC.
+	Timezone     string
+	NextDigestAt time.Time
+}
+
+// IsDigest reports whether notifications are aggregated into periodic summaries.
+func (p NotificationPreferences) IsDigest() bool {
+	return p.Mode == ModeHourlyDigest || p.Mode == ModeDailyDigest
+}
+
+// Location returns the owner timezone, UTC if it is empty or unknown.
+func (p NotificationPreferences) Location() *time.Location {
+	loc, err := time.LoadLocation(p.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// InQuietHours reports whether now falls into the quiet hours of the owner timezone.
+func (p NotificationPreferences) InQuietHours(now time.Time) bool {
+	return p.QuietHours != nil && p.QuietHours.Contains(now.In(p.Location()).Hour())
+}
+
+// ValidateTimezone accepts IANA timezone names.
+func ValidateTimezone(name string) error {
+	if name == "Local" {
+		return fmt.Errorf("%w: %s", ErrInvalidTimezone, name)
+	}
+	if _, err := time.LoadLocation(name); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidTimezone, err)
+	}
+	return nil
+}
+
+// NextDigestAt returns the start of the next digest period after now in loc, in UTC.
+func NextDigestAt(mode string, now time.Time, loc *time.Location) time.Time {
+	now = now.In(loc)
+	switch mode {
+	case ModeHourlyDigest:
+		return time.Date(now.Year(), now.Month(), now.Day(), now.Hour(), 0, 0, 0, loc).Add(time.Hour).UTC()
+	case ModeDailyDigest:
+		return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1).UTC()
+	}
+	return time.Time{}
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuietHoursContains(t *testing.T) {
+	day := QuietHours{Start: 13, End: 15}
+	require.True(t, day.Contains(13), "Start hour is not quiet")
+	require.False(t, day.Contains(15), "End hour is quiet")
+
+	night := QuietHours{Start: 22, End: 7}
+	require.True(t, night.Contains(23), "Hour before midnight is not quiet")
+	require.True(t, night.Contains(3), "Hour after midnight is not quiet")
+	require.False(t, night.Contains(12), "Day hour is quiet")
+}
+
+func TestNextDigestAt(t *testing.T) {
+	now := time.Date(2025, 3, 14, 15, 9, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2025, 3, 14, 16, 0, 0, 0, time.UTC),
+		NextDigestAt(ModeHourlyDigest, now, time.UTC), "Hourly digest mismatch")
+	require.Equal(t, time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		NextDigestAt(ModeDailyDigest, now, time.UTC), "Daily digest mismatch")
+	require.True(t, NextDigestAt(ModeImmediate, now, time.UTC).IsZero(), "Immediate mode has digest")
+
+	kolkata, err := time.LoadLocation("Asia/Kolkata")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 3, 14, 15, 30, 0, 0, time.UTC),
+		NextDigestAt(ModeHourlyDigest, now, kolkata), "Local hourly digest mismatch")
+	require.Equal(t, time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC),
+		NextDigestAt(ModeDailyDigest, now, kolkata), "Local daily digest mismatch")
+}
+
+func TestInQuietHours(t *testing.T) {
+	// 23:00 in Moscow
+	now := time.Date(2025, 3, 14, 20, 0, 0, 0, time.UTC)
+	prefs := NotificationPreferences{QuietHours: &QuietHours{Start: 22, End: 7}, Timezone: "Europe/Moscow"}
+	require.True(t, prefs.InQuietHours(now), "Local night is not quiet")
+	prefs.Timezone = ""
+	require.False(t, prefs.InQuietHours(now), "UTC evening is quiet")
+	require.False(t, NotificationPreferences{Timezone: "Europe/Moscow"}.InQuietHours(now), "Quiet without quiet hours")
+}
+
+func TestValidateTimezone(t *testing.T) {
+	require.NoError(t, ValidateTimezone("Europe/Moscow"), "Timezone rejected")
+	require.NoError(t, ValidateTimezone("UTC"), "UTC rejected")
+	require.ErrorIs(t, ValidateTimezone("Mars/Olympus"), ErrInvalidTimezone, "Unknown timezone accepted")
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+// Notification statuses
+const (
+	NotificationNew       = "new"
+	NotificationMuted     = "muted"
+	NotificationSend      = "send"
+	NotificationDelivered = "delivered"
+	NotificationRead      = "read"
+	NotificationCanceled  = "canceled"
+)
+
+type NotificationStatus struct {
+	ID          int64
+	RecipientID int64
+	Reason      string
+	Status      string
+	CreatedAt   time.Time
+	SentAt      time.Time
+	DeliveredAt time.Time
+	ReadAt      time.Time
+}
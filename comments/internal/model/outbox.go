@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// MaxOutboxRecords limits outbox rows listed or replayed by one admin call.
+const MaxOutboxRecords = 1000
+
+// OutboxFilter selects notifications of the outbox, zero fields are not applied.
+type OutboxFilter struct {
+	Status string
+	From   time.Time
+	To     time.Time
+	FromID int64
+	ToID   int64
+	Limit  int32
+}
+
+// OutboxRecord is an outbox notification with the payload it is published with.
+type OutboxRecord struct {
+	ID          int64
+	CommentID   int64
+	ProductID   int64
+	RecipientID int64
+	Reason      string
+	Status      string
+	TraceParent string
+	CreatedAt   time.Time
+	SentAt      time.Time
+	Payload     []byte
+}
+
+// OutboxMessageFilter selects messages of the generic outbox, zero fields are not applied.
+type OutboxMessageFilter struct {
+	Status string
+	Topic  string
+	FromID int64
+	ToID   int64
+	Limit  int32
+}
+
+// OutboxMessage is a message of the generic outbox.
+type OutboxMessage struct {
+	ID        int64
+	Topic     string
+	Key       string
+	Headers   map[string]string
+	Status    string
+	CreatedAt time.Time
+	SentAt    time.Time
+	Payload   []byte
+}
@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// Product event types emitted by the products service
+const (
+	EventProductDeleted      = "product.deleted"
+	EventProductRestored     = "product.restored"
+	EventProductOwnerChanged = "product.owner_changed"
+)
+
+type ProductEvent struct {
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	ProductID  int64     `json:"product_id"`
+	OldOwnerID int64     `json:"old_owner_id,omitempty"`
+	NewOwnerID int64     `json:"new_owner_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// OwnerChangeResult counts the pending notifications rewritten after an ownership change.
+type OwnerChangeResult struct {
+	Reassigned int64
+	Canceled   int64
+	Reasons    int64
+	// WebhookTasks counts the tasks scheduled again on the endpoints of the new owner
+	WebhookTasks int64
+}
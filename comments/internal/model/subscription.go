@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// ReasonSubscription marks notifications fanned out to product subscribers.
+const ReasonSubscription = "subscription"
+
+type Subscription struct {
+	UserID    int64
+	ProductID int64
+	CreatedAt time.Time
+}
+
+// FanOutTask tracks notifying the subscribers of a comment's product in batches.
+type FanOutTask struct {
+	CommentID   int64
+	ProductID   int64
+	AuthorID    int64
+	CreatedTS   time.Time
+	TraceParent string
+	LastUserID  int64
+}
@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+// User event types emitted by the users service
+const (
+	EventUserBanned   = "user.banned"
+	EventUserUnbanned = "user.unbanned"
+)
+
+type UserEvent struct {
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	UserID     int64     `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+// BanResult counts the changes made by a ban or unban.
+type BanResult struct {
+	Comments      int64
+	Notifications int64
+	// WebhookTasks and Messages count the canceled webhook tasks and deleted outbox messages
+	WebhookTasks int64
+	Messages     int64
+}
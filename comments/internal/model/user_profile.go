@@ -0,0 +1,8 @@
+package model
+
+// UserProfile is the public profile of a user from the users service.
+type UserProfile struct {
+	UserID      int64
+	DisplayName string
+	AvatarURL   string
+}
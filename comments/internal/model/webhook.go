@@ -0,0 +1,83 @@
+package model
+
+import (
+	"fmt"
+	"net/netip"
+	"net/url"
+	"strings"
+	"time"
+)
+
+type Webhook struct {
+	ID      int64
+	OwnerID int64
+	URL     string
+	Secret  string
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	URL            string
+	NotificationID int64
+	Attempt        int32
+	StatusCode     int32
+	Error          string
+	Success        bool
+	Ts             time.Time
+}
+
+// Webhook task statuses
+const (
+	WebhookTaskNew    = "new"
+	WebhookTaskDone   = "done"
+	WebhookTaskFailed = "failed"
+	// WebhookTaskCanceled tasks are not sent, their notification was canceled after scheduling
+	WebhookTaskCanceled = "canceled"
+)
+
+// WebhookTask is one notification to post to one endpoint. A failed attempt reschedules
+// the task to NextAttemptTS until the attempts run out.
+type WebhookTask struct {
+	ID             int64
+	Webhook        Webhook
+	NotificationID int64
+	Event          string
+	Payload        []byte
+	TraceParent    string
+	Attempt        int32
+	Status         string
+	NextAttemptTS  time.Time
+}
+
+// ValidateWebhookURL accepts absolute http and https URLs whose host is not a loopback, private
+// or link-local address. Host names are resolved and checked again when the request is sent.
+func ValidateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidWebhookURL, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: scheme %q is not allowed", ErrInvalidWebhookURL, u.Scheme)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host == "" {
+		return fmt.Errorf("%w: host is empty", ErrInvalidWebhookURL)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: host %s is not public", ErrInvalidWebhookURL, host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !IsPublicAddr(addr) {
+		return fmt.Errorf("%w: address %s is not public", ErrInvalidWebhookURL, addr)
+	}
+	return nil
+}
+
+// IsPublicAddr reports whether webhooks may be sent to addr.
+func IsPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsGlobalUnicast() && !addr.IsPrivate() && !sharedAddressSpace.Contains(addr)
+}
+
+// sharedAddressSpace is the carrier-grade NAT range of RFC 6598, it is not covered by IsPrivate.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	for _, raw := range []string{
+		"https://example.com/hooks",
+		"http://93.184.216.34:8080/hooks",
+		"https://[2606:2800:220:1::1]/hooks",
+	} {
+		require.NoError(t, ValidateWebhookURL(raw), "Public url %s rejected", raw)
+	}
+	for _, raw := range []string{
+		"ftp://example.com/hooks",
+		"example.com/hooks",
+		"https:///hooks",
+		"http://localhost:8080/hooks",
+		"http://127.0.0.1/hooks",
+		"http://10.0.0.5/hooks",
+		"http://192.168.1.1/hooks",
+		"http://169.254.169.254/latest/meta-data",
+		"http://100.64.0.1/hooks",
+		"http://0.0.0.0/hooks",
+		"http://[::1]/hooks",
+		"http://[fd00::1]/hooks",
+		"http://[::ffff:127.0.0.1]/hooks",
+	} {
+		require.ErrorIs(t, ValidateWebhookURL(raw), ErrInvalidWebhookURL, "Url %s accepted", raw)
+	}
+}
+
+func TestIsPublicAddr(t *testing.T) {
+	require.True(t, IsPublicAddr(netip.MustParseAddr("93.184.216.34")), "Public address rejected")
+	require.False(t, IsPublicAddr(netip.MustParseAddr("172.16.0.1")), "Private address accepted")
+	require.False(t, IsPublicAddr(netip.MustParseAddr("fe80::1")), "Link-local address accepted")
+}
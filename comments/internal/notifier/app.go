@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"context"
+	"example/comments/internal/app/config"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"example/comments/internal/repository"
+	"example/comments/internal/trace"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type App struct {
+	config   *config.Config
+	group    *consumer.Group
+	receipts *notification.ReceiptPublisher
+}
+
+func NewApp(ctx context.Context, configPath string) (*App, error) {
+	configImpl, err := config.LoadConfig(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("config.LoadConfig: %w", err)
+	}
+	trace.CreateTracerProvider(ctx, configImpl)
+
+	pool, err := connectDatabase(ctx, configImpl)
+	if err != nil {
+		return nil, err
+	}
+	rep := repository.NewRepository(pool, configImpl.NotificationConf.MaxCount)
+
+	templates, err := NewTemplates(configImpl.NotifierConf.DefaultLocale)
+	if err != nil {
+		return nil, err
+	}
+	smtpConf := configImpl.NotifierConf.SMTP
+	webhookConf := notification.WebhookConfig{
+		Timeout:              time.Duration(configImpl.NotifierConf.WebhookTimeout) * time.Millisecond,
+		AllowPrivateNetworks: configImpl.WebhookConf.AllowPrivateNetworks,
+	}
+	sinks := map[string]Sink{
+		model.ChannelLog:     LogSink{},
+		model.ChannelEmail:   NewSMTPSink(fmt.Sprintf("%s:%s", smtpConf.Host, smtpConf.Port), smtpConf.From),
+		model.ChannelWebhook: NewWebhookSink(webhookConf),
+	}
+	kafkaConf := notification.NewProducerConfig(configImpl)
+	var receipts ReceiptPublisher
+	var publisher *notification.ReceiptPublisher
+	if configImpl.ReceiptsConf.Topic != "" {
+		publisher, err = notification.NewReceiptPublisher(kafkaConf, configImpl.ReceiptsConf.Topic)
+		if err != nil {
+			return nil, err
+		}
+		receipts = publisher
+	}
+	service := NewService(rep, rep, receipts, templates, sinks,
+		configImpl.NotifierConf.DefaultChannel,
+		configImpl.NotifierConf.DefaultLocale)
+
+	group, err := consumer.New(kafkaConf.KafkaConfig, consumer.NewConfig(configImpl.NotifierConf.GroupID,
+		[]string{configImpl.NotifierConf.Topic}, configImpl.NotifierConf.Consumer), service.handle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &App{
+		config:   configImpl,
+		group:    group,
+		receipts: publisher,
+	}, nil
+}
+
+// Run consumes comment events until ctx is canceled.
+func (app *App) Run(ctx context.Context) error {
+	defer func() {
+		if app.receipts != nil {
+			if err := app.receipts.Close(); err != nil {
+				logger.Warnw(ctx, "can not close receipts producer", "error", err.Error())
+			}
+		}
+	}()
+	app.group.Run(ctx)
+	return nil
+}
+
+func connectDatabase(ctx context.Context, conf *config.Config) (*pgxpool.Pool, error) {
+	address := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
+		conf.DBConf.User, conf.DBConf.Password, conf.DBConf.Host, conf.DBConf.Port, conf.DBConf.DBName)
+	poolConf, err := pgxpool.ParseConfig(address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse repository config: %w", err)
+	}
+	pool, err := pgxpool.NewWithConfig(ctx, poolConf)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create pgx pool: %w", err)
+	}
+	return pool, nil
+}
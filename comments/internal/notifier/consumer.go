@@ -0,0 +1,51 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// handle delivers a comment event, duplicates are filtered by the inbox table. Malformed events
+// fail permanently.
+func (s *Service) handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	eventType := ""
+	for _, header := range msg.Headers {
+		if string(header.Key) == notification.EventTypeHeader {
+			eventType = string(header.Value)
+		}
+	}
+	deliver, err := s.decode(eventType, msg.Value)
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("malformed notification: %w", err))
+	}
+	return deliver(ctx)
+}
+
+// decode picks the handler by the event type header; messages without the header
+// were published before digests existed and are comment notifications.
+func (s *Service) decode(eventType string, value []byte) (func(ctx context.Context) error, error) {
+	switch eventType {
+	case notification.EventCommentDigest:
+		digest := notification.DigestNotification{}
+		if err := json.Unmarshal(value, &digest); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return s.HandleDigest(ctx, digest)
+		}, nil
+	case notification.EventCommentCreated, "":
+		ntf := notification.CommentNotification{}
+		if err := json.Unmarshal(value, &ntf); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return s.Handle(ctx, ntf)
+		}, nil
+	}
+	return nil, fmt.Errorf("unknown event type: %s", eventType)
+}
@@ -0,0 +1,177 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const consumerName = "notifier"
+
+type InboxRepository interface {
+	StartEvent(ctx context.Context, consumer string, eventID string) (bool, error)
+	CompleteEvent(ctx context.Context, consumer string, eventID string) error
+}
+
+type ChannelRepository interface {
+	GetNotificationChannel(ctx context.Context, ownerID int64) (model.NotificationChannel, error)
+}
+
+// ReceiptPublisher reports delivered notifications back to the comments service.
+type ReceiptPublisher interface {
+	Publish(ctx context.Context, receipt notification.DeliveryReceipt) error
+}
+
+type Service struct {
+	inbox          InboxRepository
+	receipts       ReceiptPublisher
+	channels       ChannelRepository
+	templates      *Templates
+	sinks          map[string]Sink
+	defaultChannel string
+	defaultLocale  string
+}
+
+// NewService creates the delivery service, receipts may be nil when receipts are disabled.
+func NewService(inbox InboxRepository, channels ChannelRepository, receipts ReceiptPublisher, templates *Templates,
+	sinks map[string]Sink, defaultChannel string, defaultLocale string) *Service {
+	return &Service{
+		inbox:          inbox,
+		receipts:       receipts,
+		channels:       channels,
+		templates:      templates,
+		sinks:          sinks,
+		defaultChannel: defaultChannel,
+		defaultLocale:  defaultLocale,
+	}
+}
+
+// Handle delivers a comment notification at least once per outbox row. The inbox row is
+// committed as processing before the delivery and marked done after it, so no transaction is
+// open while the sink is called. A delivered notification is skipped on redelivery, a failed or
+// interrupted delivery is retried and may reach the recipient twice.
+func (s *Service) Handle(ctx context.Context, ntf notification.CommentNotification) error {
+	eventID := strconv.FormatInt(ntf.ID, 10)
+	started, err := s.inbox.StartEvent(ctx, consumerName, eventID)
+	if err != nil {
+		return err
+	}
+	if !started {
+		logger.Infow(ctx, "notification already processed", "notification_id", ntf.ID)
+		return nil
+	}
+	if err = s.deliver(ctx, ntf); err != nil {
+		return err
+	}
+	s.complete(ctx, eventID)
+	s.publishReceipt(ctx, []int64{ntf.ID})
+	return nil
+}
+
+// HandleDigest delivers a digest notification at least once, like Handle.
+func (s *Service) HandleDigest(ctx context.Context, digest notification.DigestNotification) error {
+	started, err := s.inbox.StartEvent(ctx, consumerName, digest.ID)
+	if err != nil {
+		return err
+	}
+	if !started {
+		logger.Infow(ctx, "digest already processed", "digest_id", digest.ID)
+		return nil
+	}
+	if err = s.deliverDigest(ctx, digest); err != nil {
+		return err
+	}
+	s.complete(ctx, digest.ID)
+	s.publishReceipt(ctx, digest.NotificationIDs)
+	return nil
+}
+
+// complete marks a delivered event done. The delivery is not retried if marking fails, a
+// redelivery of the event would send it again.
+func (s *Service) complete(ctx context.Context, eventID string) {
+	if err := s.inbox.CompleteEvent(ctx, consumerName, eventID); err != nil {
+		logger.Warnw(ctx, "can not complete notification event", "error", err.Error(), "event_id", eventID)
+	}
+}
+
+// publishReceipt is best effort: a lost receipt leaves the notification in the sent state.
+func (s *Service) publishReceipt(ctx context.Context, notificationIDs []int64) {
+	if s.receipts == nil || len(notificationIDs) == 0 {
+		return
+	}
+	_ = s.receipts.Publish(ctx, notification.DeliveryReceipt{
+		NotificationIDs: notificationIDs,
+		State:           notification.StateDelivered,
+		Time:            time.Now(),
+	})
+}
+
+func (s *Service) deliver(ctx context.Context, ntf notification.CommentNotification) error {
+	channel, sink, err := s.resolveSink(ctx, ntf.RecipientID)
+	if err != nil {
+		return err
+	}
+	subject, body, err := s.templates.Render(channel.Locale, ntf)
+	if err != nil {
+		return consumer.Permanent(err)
+	}
+	return sink.Send(ctx, Message{
+		RecipientID: ntf.RecipientID,
+		CommentID:   ntf.CommentID,
+		Address:     channel.Address,
+		Secret:      channel.Secret,
+		Event:       notification.EventForReason(ntf.Reason),
+		Subject:     subject,
+		Body:        body,
+	})
+}
+
+func (s *Service) deliverDigest(ctx context.Context, digest notification.DigestNotification) error {
+	channel, sink, err := s.resolveSink(ctx, digest.RecipientID)
+	if err != nil {
+		return err
+	}
+	subject, body, err := s.templates.RenderDigest(channel.Locale, digest)
+	if err != nil {
+		return consumer.Permanent(err)
+	}
+	return sink.Send(ctx, Message{
+		RecipientID: digest.RecipientID,
+		CommentIDs:  digest.CommentIDs,
+		Address:     channel.Address,
+		Secret:      channel.Secret,
+		Event:       notification.EventCommentDigest,
+		Subject:     subject,
+		Body:        body,
+	})
+}
+
+func (s *Service) resolveSink(ctx context.Context, ownerID int64) (model.NotificationChannel, Sink, error) {
+	channel, err := s.resolveChannel(ctx, ownerID)
+	if err != nil {
+		return model.NotificationChannel{}, nil, err
+	}
+	sink, ok := s.sinks[channel.Kind]
+	if !ok {
+		return model.NotificationChannel{}, nil, consumer.Permanent(fmt.Errorf("unknown notification channel: %s", channel.Kind))
+	}
+	return channel, sink, nil
+}
+
+func (s *Service) resolveChannel(ctx context.Context, ownerID int64) (model.NotificationChannel, error) {
+	channel, err := s.channels.GetNotificationChannel(ctx, ownerID)
+	if errors.Is(err, model.ErrNotificationChannelNotFound) {
+		return model.NotificationChannel{
+			OwnerID: ownerID,
+			Kind:    s.defaultChannel,
+			Locale:  s.defaultLocale,
+		}, nil
+	}
+	return channel, err
+}
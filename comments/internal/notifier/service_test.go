@@ -0,0 +1,205 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type inboxStub struct {
+	processed map[string]bool
+}
+
+func (i *inboxStub) StartEvent(_ context.Context, _ string, eventID string) (bool, error) {
+	return !i.processed[eventID], nil
+}
+
+func (i *inboxStub) CompleteEvent(_ context.Context, _ string, eventID string) error {
+	i.processed[eventID] = true
+	return nil
+}
+
+type channelsStub struct {
+	channels map[int64]model.NotificationChannel
+	err      error
+}
+
+func (c *channelsStub) GetNotificationChannel(_ context.Context, ownerID int64) (model.NotificationChannel, error) {
+	if c.err != nil {
+		return model.NotificationChannel{}, c.err
+	}
+	channel, ok := c.channels[ownerID]
+	if !ok {
+		return model.NotificationChannel{}, model.ErrNotificationChannelNotFound
+	}
+	return channel, nil
+}
+
+type sinkStub struct {
+	sent []Message
+	err  error
+}
+
+func (s *sinkStub) Send(_ context.Context, msg Message) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, msg)
+	return nil
+}
+
+type receiptsStub struct {
+	receipts []notification.DeliveryReceipt
+}
+
+func (r *receiptsStub) Publish(_ context.Context, receipt notification.DeliveryReceipt) error {
+	r.receipts = append(r.receipts, receipt)
+	return nil
+}
+
+func newTestService(t *testing.T, channels *channelsStub, sinks map[string]Sink) (*Service, *receiptsStub) {
+	templates, err := NewTemplates("ru")
+	require.NoError(t, err, "Can not load templates")
+	receipts := &receiptsStub{}
+	return NewService(&inboxStub{processed: map[string]bool{}}, channels, receipts, templates, sinks, model.ChannelLog, "ru"), receipts
+}
+
+func TestHandleDeliversOnce(t *testing.T) {
+	email := &sinkStub{}
+	log := &sinkStub{}
+	channels := &channelsStub{channels: map[int64]model.NotificationChannel{
+		173: {OwnerID: 173, Kind: model.ChannelEmail, Address: "owner@example.com", Locale: "en"},
+	}}
+	service, receipts := newTestService(t, channels, map[string]Sink{model.ChannelEmail: email, model.ChannelLog: log})
+
+	ntf := notification.CommentNotification{ID: 1, RecipientID: 173, Reason: "new_comment", CommentID: 42}
+	require.NoError(t, service.Handle(context.Background(), ntf), "Handle failed")
+	require.NoError(t, service.Handle(context.Background(), ntf), "Duplicate handle failed")
+	require.Equal(t, 1, len(email.sent), "Deliveries mismatch")
+	require.Equal(t, "owner@example.com", email.sent[0].Address, "Address mismatch")
+	require.Equal(t, "New review of your product", email.sent[0].Subject, "Locale mismatch")
+	require.Equal(t, 1, len(receipts.receipts), "Receipts mismatch")
+	require.Equal(t, []int64{1}, receipts.receipts[0].NotificationIDs, "Receipt notifications mismatch")
+
+	// a recipient without a channel gets the default one
+	require.NoError(t, service.Handle(context.Background(), notification.CommentNotification{ID: 2, RecipientID: 174, Reason: "new_comment"}))
+	require.Equal(t, 1, len(log.sent), "Default channel deliveries mismatch")
+}
+
+func TestHandleRetriesFailedDelivery(t *testing.T) {
+	email := &sinkStub{err: errors.New("connection refused")}
+	channels := &channelsStub{channels: map[int64]model.NotificationChannel{
+		173: {OwnerID: 173, Kind: model.ChannelEmail, Address: "owner@example.com", Locale: "en"},
+	}}
+	service, receipts := newTestService(t, channels, map[string]Sink{model.ChannelEmail: email})
+
+	ntf := notification.CommentNotification{ID: 1, RecipientID: 173, Reason: "new_comment", CommentID: 42}
+	require.Error(t, service.Handle(context.Background(), ntf), "Failed delivery is not reported")
+	require.Equal(t, 0, len(receipts.receipts), "Receipt of a failed delivery")
+	email.err = nil
+	require.NoError(t, service.Handle(context.Background(), ntf), "Redelivery failed")
+	require.Equal(t, 1, len(email.sent), "Failed delivery is not retried")
+	require.Equal(t, 1, len(receipts.receipts), "Receipts mismatch")
+}
+
+func TestHandleClassifiesErrors(t *testing.T) {
+	channels := &channelsStub{channels: map[int64]model.NotificationChannel{
+		173: {OwnerID: 173, Kind: "sms"},
+		174: {OwnerID: 174, Kind: model.ChannelWebhook},
+	}}
+	service, receipts := newTestService(t, channels, map[string]Sink{
+		model.ChannelLog:     &sinkStub{err: errors.New("log is not available")},
+		model.ChannelWebhook: NewWebhookSink(notification.WebhookConfig{}),
+	})
+
+	err := service.Handle(context.Background(), notification.CommentNotification{ID: 1, RecipientID: 173, Reason: "new_comment"})
+	require.True(t, consumer.IsPermanent(err), "Unknown channel is retried")
+
+	err = service.Handle(context.Background(), notification.CommentNotification{ID: 2, RecipientID: 174, Reason: "new_comment"})
+	require.True(t, consumer.IsPermanent(err), "Webhook without url is retried")
+
+	err = service.Handle(context.Background(), notification.CommentNotification{ID: 3, RecipientID: 175, Reason: "new_comment"})
+	require.Error(t, err, "Sink failure is lost")
+	require.False(t, consumer.IsPermanent(err), "Sink failure is not retried")
+
+	channels.err = errors.New("database is not available")
+	err = service.Handle(context.Background(), notification.CommentNotification{ID: 4, RecipientID: 173, Reason: "new_comment"})
+	require.False(t, consumer.IsPermanent(err), "Database failure is not retried")
+	require.Equal(t, 0, len(receipts.receipts), "Receipt of a failed delivery")
+}
+
+func TestWebhookSinkStatuses(t *testing.T) {
+	status := http.StatusBadRequest
+	var req *http.Request
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+	sink := NewWebhookSink(notification.WebhookConfig{AllowPrivateNetworks: true})
+	msg := Message{RecipientID: 173, Address: server.URL, Secret: "0123456789abcdef", Event: notification.EventCommentCreated}
+
+	require.True(t, consumer.IsPermanent(sink.Send(context.Background(), msg)), "Client error is retried")
+	status = http.StatusTooManyRequests
+	err := sink.Send(context.Background(), msg)
+	require.Error(t, err, "Rate limit is lost")
+	require.False(t, consumer.IsPermanent(err), "Rate limit is not retried")
+	status = http.StatusServiceUnavailable
+	require.False(t, consumer.IsPermanent(sink.Send(context.Background(), msg)), "Server error is not retried")
+	status = http.StatusNoContent
+	require.NoError(t, sink.Send(context.Background(), msg), "Send failed")
+
+	timestamp := req.Header.Get(notification.TimestampHeader)
+	require.Equal(t, notification.EventCommentCreated, req.Header.Get(notification.EventHeader), "Event mismatch")
+	require.Equal(t, notification.SignatureHeaderValue(msg.Secret, timestamp, body),
+		req.Header.Get(notification.SignatureHeader), "Signature mismatch")
+
+	msg.Secret = ""
+	require.True(t, consumer.IsPermanent(sink.Send(context.Background(), msg)), "Webhook without secret is retried")
+}
+
+func TestWebhookSinkRefusesPrivateAddresses(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		called = true
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	sink := NewWebhookSink(notification.WebhookConfig{})
+	err := sink.Send(context.Background(), Message{RecipientID: 173, Address: server.URL, Secret: "0123456789abcdef"})
+	require.True(t, consumer.IsPermanent(err), "Private address is retried")
+	require.ErrorIs(t, err, model.ErrInvalidWebhookURL, "Private address is not refused")
+	require.False(t, called, "Private address is called")
+}
+
+func TestEmailMessageHeaders(t *testing.T) {
+	to, body, err := emailMessage("notifications@comments.local", Message{
+		RecipientID: 174,
+		Address:     "owner@example.com",
+		Subject:     "Новый комментарий",
+		Body:        "text",
+	})
+	require.NoError(t, err, "Email is not built")
+	require.Equal(t, "owner@example.com", to, "Recipient mismatch")
+	require.Contains(t, string(body), "Subject: =?utf-8?q?", "Subject is not encoded")
+	require.NotContains(t, string(body), "Новый", "Subject is sent raw")
+
+	for _, msg := range []Message{
+		{RecipientID: 174, Address: "owner@example.com\r\nBcc: other@example.com"},
+		{RecipientID: 174, Address: "owner@example.com", Subject: "hi\r\nBcc: other@example.com"},
+		{RecipientID: 174, Address: "not an address"},
+	} {
+		_, _, err := emailMessage("notifications@comments.local", msg)
+		require.Error(t, err, "Unsafe message is built: %q %q", msg.Address, msg.Subject)
+	}
+}
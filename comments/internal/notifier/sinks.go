@@ -0,0 +1,176 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"example/comments/internal/trace"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type Message struct {
+	RecipientID int64 `json:"recipient_id"`
+	CommentID   int64 `json:"comment_id"`
+	// CommentIDs lists the summarized comments of a digest message
+	CommentIDs []int64 `json:"comment_ids,omitempty"`
+	Address    string  `json:"-"`
+	// Secret signs webhook requests, Event is sent in their event header
+	Secret  string `json:"-"`
+	Event   string `json:"-"`
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// Sink delivers a message, errors that fail again on retry are wrapped with consumer.Permanent.
+type Sink interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// LogSink only writes notifications to the service log.
+type LogSink struct{}
+
+func (LogSink) Send(ctx context.Context, msg Message) error {
+	logger.Infow(ctx, "notification delivered to log",
+		"recipient_id", msg.RecipientID,
+		"comment_id", msg.CommentID,
+		"subject", msg.Subject,
+		"body", msg.Body)
+	return nil
+}
+
+// SMTPSink sends notifications as plain text emails without authentication,
+// which is enough for a local SMTP stand-in.
+type SMTPSink struct {
+	address string
+	from    string
+}
+
+func NewSMTPSink(address string, from string) *SMTPSink {
+	return &SMTPSink{
+		address: address,
+		from:    from,
+	}
+}
+
+func (s *SMTPSink) Send(_ context.Context, msg Message) error {
+	if msg.Address == "" {
+		return consumer.Permanent(fmt.Errorf("recipient %d has no email address", msg.RecipientID))
+	}
+	to, body, err := emailMessage(s.from, msg)
+	if err != nil {
+		return consumer.Permanent(err)
+	}
+	if err := smtp.SendMail(s.address, nil, s.from, []string{to}, body); err != nil {
+		err = fmt.Errorf("send email failed: %w", err)
+		// 5xx replies reject the message or the address, 4xx replies are temporary
+		var reply *textproto.Error
+		if errors.As(err, &reply) && reply.Code >= 500 {
+			return consumer.Permanent(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// emailMessage builds the email of msg and returns its bare recipient address. The addresses are
+// parsed and the subject is Q-encoded, so that neither of them can add headers to the message.
+func emailMessage(from string, msg Message) (string, []byte, error) {
+	if strings.ContainsAny(msg.Address, "\r\n") || strings.ContainsAny(msg.Subject, "\r\n") {
+		return "", nil, fmt.Errorf("recipient %d address or subject contains a line break", msg.RecipientID)
+	}
+	to, err := mail.ParseAddress(msg.Address)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid email address of recipient %d: %w", msg.RecipientID, err)
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+	body := strings.Join([]string{
+		"From: " + sender.String(),
+		"To: " + to.String(),
+		"Subject: " + mime.QEncoding.Encode("utf-8", msg.Subject),
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		msg.Body,
+	}, "\r\n")
+	return to.Address, []byte(body), nil
+}
+
+// WebhookSink posts notifications as JSON to the owner's URL, signed like the webhooks of the
+// comments service. Internal addresses are refused unless conf.AllowPrivateNetworks is set.
+type WebhookSink struct {
+	client *http.Client
+}
+
+func NewWebhookSink(conf notification.WebhookConfig) *WebhookSink {
+	return &WebhookSink{
+		client: notification.NewWebhookClient(conf),
+	}
+}
+
+func (s *WebhookSink) Send(ctx context.Context, msg Message) error {
+	if msg.Address == "" {
+		return consumer.Permanent(fmt.Errorf("recipient %d has no webhook url", msg.RecipientID))
+	}
+	if msg.Secret == "" {
+		return consumer.Permanent(fmt.Errorf("recipient %d has no webhook secret", msg.RecipientID))
+	}
+	payload, err := json.Marshal(msg)
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("marshal webhook payload failed: %w", err))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, msg.Address, bytes.NewReader(payload))
+	if err != nil {
+		return consumer.Permanent(fmt.Errorf("create webhook request failed: %w", err))
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(notification.EventHeader, msg.Event)
+	req.Header.Set(notification.TimestampHeader, timestamp)
+	req.Header.Set(notification.SignatureHeader, notification.SignatureHeaderValue(msg.Secret, timestamp, payload))
+	trace.Propagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+	resp, err := s.client.Do(req)
+	if err != nil {
+		err = fmt.Errorf("webhook request failed: %w", err)
+		if errors.Is(err, model.ErrInvalidWebhookURL) {
+			return consumer.Permanent(err)
+		}
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		err = fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+		if isPermanentStatus(resp.StatusCode) {
+			return consumer.Permanent(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// isPermanentStatus reports whether the endpoint rejects the request itself, client errors other
+// than timeouts and rate limits are not fixed by a retry.
+func isPermanentStatus(code int) bool {
+	return code >= 400 && code < 500 && code != http.StatusRequestTimeout && code != http.StatusTooManyRequests
+}
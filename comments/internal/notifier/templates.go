@@ -0,0 +1,76 @@
+package notifier
+
+import (
+	"bytes"
+	"embed"
+	"example/comments/internal/external/notification"
+	"fmt"
+	"path"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates/*.tmpl
+var templatesFS embed.FS
+
+type Templates struct {
+	byLocale      map[string]*template.Template
+	defaultLocale string
+}
+
+func NewTemplates(defaultLocale string) (*Templates, error) {
+	files, err := templatesFS.ReadDir("templates")
+	if err != nil {
+		return nil, fmt.Errorf("read templates failed: %w", err)
+	}
+	t := &Templates{
+		byLocale:      make(map[string]*template.Template, len(files)),
+		defaultLocale: defaultLocale,
+	}
+	for _, f := range files {
+		locale := strings.TrimSuffix(f.Name(), path.Ext(f.Name()))
+		tmpl, err := template.ParseFS(templatesFS, path.Join("templates", f.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("parse template %s failed: %w", f.Name(), err)
+		}
+		t.byLocale[locale] = tmpl
+	}
+	if _, ok := t.byLocale[defaultLocale]; !ok {
+		return nil, fmt.Errorf("no templates for default locale %s", defaultLocale)
+	}
+	return t, nil
+}
+
+// Render renders the subject and body of a notification, falling back to the default locale.
+func (t *Templates) Render(locale string, ntf notification.CommentNotification) (subject string, body string, err error) {
+	return t.render(locale, "", ntf)
+}
+
+// RenderDigest renders the subject and body of a digest notification.
+func (t *Templates) RenderDigest(locale string, digest notification.DigestNotification) (subject string, body string, err error) {
+	return t.render(locale, "digest_", digest)
+}
+
+func (t *Templates) render(locale string, prefix string, data any) (subject string, body string, err error) {
+	tmpl, ok := t.byLocale[locale]
+	if !ok {
+		tmpl = t.byLocale[t.defaultLocale]
+	}
+	subject, err = execute(tmpl, prefix+"subject", data)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = execute(tmpl, prefix+"body", data)
+	if err != nil {
+		return "", "", err
+	}
+	return subject, body, nil
+}
+
+func execute(tmpl *template.Template, name string, data any) (string, error) {
+	buf := bytes.Buffer{}
+	if err := tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		return "", fmt.Errorf("render %s failed: %w", name, err)
+	}
+	return buf.String(), nil
+}
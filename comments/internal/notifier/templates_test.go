@@ -0,0 +1,58 @@
+package notifier
+
+import (
+	"example/comments/internal/external/notification"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemplatesRender(t *testing.T) {
+	templates, err := NewTemplates("ru")
+	require.NoError(t, err, "Can not load templates")
+	ntf := notification.CommentNotification{
+		ID:          1,
+		RecipientID: 173,
+		Reason:      "new_comment",
+		CommentID:   42,
+		CreatedTS:   time.Date(2025, 3, 14, 15, 9, 0, 0, time.UTC),
+	}
+
+	subject, body, err := templates.Render("en", ntf)
+	require.NoError(t, err, "Can not render en template")
+	require.Equal(t, "New review of your product", subject, "Subject mismatch")
+	require.Contains(t, body, "#42", "Body has no comment ID")
+
+	subject, body, err = templates.Render("de", ntf)
+	require.NoError(t, err, "Can not render fallback template")
+	require.Equal(t, "Новый отзыв на ваш товар", subject, "Fallback subject mismatch")
+	require.Contains(t, body, "14.03.2025 15:09", "Body has no creation time")
+
+	ntf.Reason = "owner_answer"
+	subject, _, err = templates.Render("en", ntf)
+	require.NoError(t, err, "Can not render owner answer template")
+	require.Equal(t, "The seller answered your review", subject, "Owner answer subject mismatch")
+}
+
+func TestTemplatesRenderDigest(t *testing.T) {
+	templates, err := NewTemplates("ru")
+	require.NoError(t, err, "Can not load templates")
+	digest := notification.NewDigestNotification(173, "daily", []notification.CommentNotification{
+		{ID: 1, CommentID: 42, ProductID: 100, CreatedTS: time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC)},
+		{ID: 2, CommentID: 43, ProductID: 100, CreatedTS: time.Date(2025, 3, 14, 12, 0, 0, 0, time.UTC)},
+		{ID: 5, CommentID: 47, ProductID: 101, CreatedTS: time.Date(2025, 3, 14, 18, 30, 0, 0, time.UTC)},
+	})
+	require.Equal(t, "digest:173:5", digest.ID, "Digest ID mismatch")
+	require.Equal(t, []int64{100, 101}, digest.ProductIDs, "Digest products mismatch")
+
+	subject, body, err := templates.RenderDigest("en", digest)
+	require.NoError(t, err, "Can not render en digest")
+	require.Equal(t, "New reviews for you: 3", subject, "Subject mismatch")
+	require.Contains(t, body, "Mar 14, 2025 18:30", "Body has no period end")
+}
+
+func TestTemplatesUnknownDefaultLocale(t *testing.T) {
+	_, err := NewTemplates("de")
+	require.Error(t, err, "Loaded templates without default locale")
+}
@@ -0,0 +1,41 @@
+package outbox
+
+import (
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var _ propagation.TextMapCarrier = (*HeadersCarrier)(nil)
+
+// HeadersCarrier adapts sarama record headers to the otel propagation API.
+type HeadersCarrier []sarama.RecordHeader
+
+func (c *HeadersCarrier) Get(key string) string {
+	for _, h := range *c {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c *HeadersCarrier) Set(key string, value string) {
+	for i, h := range *c {
+		if string(h.Key) == key {
+			(*c)[i].Value = []byte(value)
+			return
+		}
+	}
+	*c = append(*c, sarama.RecordHeader{
+		Key:   []byte(key),
+		Value: []byte(value),
+	})
+}
+
+func (c *HeadersCarrier) Keys() []string {
+	keys := make([]string, len(*c))
+	for i, h := range *c {
+		keys[i] = string(h.Key)
+	}
+	return keys
+}
@@ -0,0 +1,91 @@
+package outbox
+
+import (
+	"context"
+	"example/comments/internal/logger"
+	"example/comments/internal/trace"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+// Send publishes the payload under a producer span started from ctx. The span context replaces
+// the trace headers, other headers are copied to the message as is. An empty key lets the
+// partitioner choose the partition.
+func Send(ctx context.Context, prod sarama.SyncProducer, topic string, key string, payload []byte,
+	headers map[string]string, opts ...oteltrace.SpanStartOption) (context.Context, error) {
+	attrs := []attribute.KeyValue{
+		semconv.MessagingSystemKafka,
+		semconv.MessagingOperationTypePublish,
+		semconv.MessagingDestinationName(topic),
+	}
+	if key != "" {
+		attrs = append(attrs, semconv.MessagingKafkaMessageKey(key))
+	}
+	opts = append(opts, oteltrace.WithSpanKind(oteltrace.SpanKindProducer), oteltrace.WithAttributes(attrs...))
+	msgCtx, span := trace.Tracer().Start(ctx, topic+" publish", opts...)
+	defer span.End()
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	carrier := HeadersCarrier{}
+	for _, k := range keys {
+		carrier.Set(k, headers[k])
+	}
+	trace.Propagator().Inject(msgCtx, &carrier)
+	msg := &sarama.ProducerMessage{
+		Topic:     topic,
+		Value:     sarama.ByteEncoder(payload),
+		Headers:   carrier,
+		Timestamp: time.Now(),
+	}
+	if key != "" {
+		msg.Key = sarama.StringEncoder(key)
+	}
+	partition, offset, err := prod.SendMessage(msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, "send message failed")
+		logger.Warnw(msgCtx, "can not send message", "error", err.Error(), "topic", topic)
+		return msgCtx, err
+	}
+	span.SetAttributes(
+		semconv.MessagingDestinationPartitionID(strconv.Itoa(int(partition))),
+		semconv.MessagingKafkaMessageOffset(int(offset)),
+	)
+	return msgCtx, nil
+}
+
+// InTxn runs send inside a transaction of the transactional producer prod. The transaction
+// is aborted when send or the commit fails.
+func InTxn(ctx context.Context, prod sarama.SyncProducer, send func() error) error {
+	if err := prod.BeginTxn(); err != nil {
+		logger.Warnw(ctx, "can not begin kafka transaction", "error", err.Error())
+		return err
+	}
+	if err := send(); err != nil {
+		abortTxn(ctx, prod)
+		return err
+	}
+	if err := prod.CommitTxn(); err != nil {
+		logger.Warnw(ctx, "can not commit kafka transaction", "error", err.Error())
+		abortTxn(ctx, prod)
+		return err
+	}
+	return nil
+}
+
+func abortTxn(ctx context.Context, prod sarama.SyncProducer) {
+	if err := prod.AbortTxn(); err != nil {
+		logger.Warnw(ctx, "can not abort kafka transaction", "error", err.Error())
+	}
+}
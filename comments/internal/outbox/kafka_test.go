@@ -0,0 +1,45 @@
+package outbox
+
+import (
+	"context"
+	"example/comments/internal/trace"
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestSendPropagatesTraceContext(t *testing.T) {
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	reqCtx, span := trace.Tracer().Start(context.Background(), "CreateComment")
+	span.End()
+	original := span.SpanContext()
+
+	// the traceparent is stored in the outbox row and restored by the publisher
+	traceParent := trace.TraceParent(reqCtx)
+	require.NotNil(t, traceParent, "Traceparent is not stored")
+	pubCtx := trace.ContextWithTraceParent(context.Background(), *traceParent)
+
+	var headers []sarama.RecordHeader
+	prod := mocks.NewSyncProducer(t, nil)
+	prod.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		headers = msg.Headers
+		return nil
+	})
+	msgCtx, err := Send(pubCtx, prod, "comments.create-comment", "1", []byte("{}"), map[string]string{EventTypeHeader: "comment.created"})
+	require.NoError(t, err, "Send failed")
+	require.NoError(t, prod.Close())
+
+	carrier := HeadersCarrier(headers)
+	require.Equal(t, "comment.created", carrier.Get(EventTypeHeader), "Event type mismatch")
+	consumed := oteltrace.SpanContextFromContext(trace.Propagator().Extract(context.Background(), &carrier))
+	producer := oteltrace.SpanContextFromContext(msgCtx)
+	require.True(t, consumed.IsRemote(), "Extracted span is not remote")
+	require.Equal(t, original.TraceID(), consumed.TraceID(), "Trace id mismatch")
+	require.Equal(t, producer.SpanID(), consumed.SpanID(), "Parent span is not the producer span")
+	require.NotEqual(t, original.SpanID(), consumed.SpanID(), "Producer span is not started")
+}
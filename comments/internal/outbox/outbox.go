@@ -0,0 +1,64 @@
+// Package outbox stores events in the same database transaction as the change that produced
+// them and publishes them to Kafka afterwards, so an event is published if and only if the
+// change is committed.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/trace"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// EventTypeHeader names the header consumers use to tell the events of one topic apart.
+const EventTypeHeader = "event_type"
+
+// DBTX is satisfied by pgx.Tx, pgx.Conn and pgxpool.Pool.
+type DBTX interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+}
+
+type Message struct {
+	ID int64
+	// Topic is a logical topic, the publisher maps it to a Kafka topic.
+	Topic     string
+	Key       string
+	Payload   []byte
+	Headers   map[string]string
+	CreatedTS time.Time
+	// Status is new until the message is published, then send.
+	Status string
+	SentTS time.Time
+}
+
+const enqueue = `INSERT INTO outbox (topic, key, payload, headers)
+VALUES ($1, $2, $3, $4)
+RETURNING id
+`
+
+// Enqueue saves a message within tx. The trace of ctx is stored in the headers, so the
+// published message continues the trace of the request that produced it.
+func Enqueue(ctx context.Context, tx DBTX, topic string, key string, payload []byte, headers map[string]string) (int64, error) {
+	carrier := make(propagation.MapCarrier, len(headers)+1)
+	for k, v := range headers {
+		carrier[k] = v
+	}
+	trace.Propagator().Inject(ctx, carrier)
+	bytes, err := json.Marshal(carrier)
+	if err != nil {
+		return 0, fmt.Errorf("marshal outbox headers failed: %w", err)
+	}
+	var id int64
+	err = tx.QueryRow(ctx, enqueue, topic, key, payload, bytes).Scan(&id)
+	if err != nil {
+		return 0, fmt.Errorf("enqueue outbox message failed: %w", err)
+	}
+	return id, nil
+}
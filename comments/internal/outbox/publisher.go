@@ -0,0 +1,134 @@
+package outbox
+
+import (
+	"context"
+	"example/comments/internal/logger"
+	"example/comments/internal/trace"
+	"time"
+
+	"github.com/IBM/sarama"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+type Repository interface {
+	ClaimPendingMessages(_ context.Context, limit int32, leaseUntil time.Time) ([]Message, error)
+	MarkMessagesSent(_ context.Context, ids []int64) error
+}
+
+type Config struct {
+	Timer     time.Duration
+	BatchSize int
+	// Timeout bounds one publishing tick.
+	Timeout time.Duration
+	// Lease is how long a claimed batch is hidden from other publishers, it must exceed Timeout.
+	Lease time.Duration
+	// Routes maps logical topics to Kafka topics, a topic without a route is published as is.
+	Routes map[string]string
+	// Transactional publishes each batch inside one Kafka transaction, prod must be transactional.
+	Transactional bool
+}
+
+// Publisher sends outbox messages of all topics in the order they were enqueued. A batch
+// stops at the first failed message, so messages with the same key are never reordered.
+// Batches are leased, so replicas of the service publish different messages.
+type Publisher struct {
+	rep       Repository
+	producers ProducerSource
+	conf      Config
+}
+
+func NewPublisher(rep Repository, producers ProducerSource, conf Config) *Publisher {
+	return &Publisher{
+		rep:       rep,
+		producers: producers,
+		conf:      conf,
+	}
+}
+
+// StartPublisher publishes pending messages every conf.Timer while the producer is connected.
+func StartPublisher(ctx context.Context, rep Repository, producers ProducerSource, conf Config) {
+	publisher := NewPublisher(rep, producers, conf)
+	go func(p *Publisher) {
+		ticker := time.NewTicker(p.conf.Timer)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Infow(ctx, "outbox publisher context closed")
+				return
+			case <-ticker.C:
+				tickCtx, cancel := context.WithTimeout(ctx, p.conf.Timeout)
+				p.Publish(tickCtx)
+				cancel()
+			}
+		}
+	}(publisher)
+}
+
+// Route returns the Kafka topic of a logical topic.
+func (p *Publisher) Route(topic string) string {
+	if route, ok := p.conf.Routes[topic]; ok {
+		return route
+	}
+	return topic
+}
+
+func (p *Publisher) Publish(ctx context.Context) {
+	prod, err := p.producers.Producer()
+	if err != nil {
+		return
+	}
+	msgs, err := p.rep.ClaimPendingMessages(ctx, int32(p.conf.BatchSize), time.Now().Add(p.conf.Lease))
+	if err != nil {
+		logger.Warnw(ctx, "can not get outbox messages", "error", err.Error())
+		return
+	}
+	if len(msgs) == 0 {
+		return
+	}
+	sent := make([]int64, 0, len(msgs))
+	send := func() error {
+		for _, msg := range msgs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			if err := p.send(ctx, prod, msg); err != nil {
+				return err
+			}
+			sent = append(sent, msg.ID)
+		}
+		return nil
+	}
+	if p.conf.Transactional {
+		err = InTxn(ctx, prod, send)
+	} else {
+		err = send()
+	}
+	if err != nil {
+		p.producers.Failed(prod, err)
+		if p.conf.Transactional {
+			return
+		}
+	}
+	if len(sent) == 0 {
+		return
+	}
+	// sent messages are marked even if the tick timed out, otherwise they are published again
+	if err = p.rep.MarkMessagesSent(context.WithoutCancel(ctx), sent); err != nil {
+		logger.Warnw(ctx, "can not mark outbox messages as sent", "error", err.Error())
+	}
+}
+
+// send publishes msg under a span that continues the trace stored at enqueue time.
+func (p *Publisher) send(ctx context.Context, prod sarama.SyncProducer, msg Message) error {
+	msgCtx := trace.Propagator().Extract(ctx, propagation.MapCarrier(msg.Headers))
+	msgCtx, err := Send(msgCtx, prod, p.Route(msg.Topic), msg.Key, msg.Payload, msg.Headers)
+	if err != nil {
+		return err
+	}
+	logger.Infow(msgCtx, "send outbox message",
+		"id", msg.ID,
+		"topic", msg.Topic,
+		"key", msg.Key)
+	return nil
+}
@@ -0,0 +1,98 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+type repositoryStub struct {
+	msgs       []Message
+	sent       []int64
+	leaseUntil time.Time
+}
+
+func (r *repositoryStub) ClaimPendingMessages(_ context.Context, _ int32, leaseUntil time.Time) ([]Message, error) {
+	r.leaseUntil = leaseUntil
+	return r.msgs, nil
+}
+
+func (r *repositoryStub) MarkMessagesSent(_ context.Context, ids []int64) error {
+	r.sent = append(r.sent, ids...)
+	return nil
+}
+
+type producerStub struct {
+	prod   sarama.SyncProducer
+	failed []error
+}
+
+func (p *producerStub) Producer() (sarama.SyncProducer, error) {
+	return p.prod, nil
+}
+
+func (p *producerStub) Failed(_ sarama.SyncProducer, err error) {
+	p.failed = append(p.failed, err)
+}
+
+func TestPublisherRoutesTopics(t *testing.T) {
+	rep := &repositoryStub{msgs: []Message{
+		{ID: 1, Topic: "comment-events", Key: "1", Payload: []byte("{}"), Headers: map[string]string{EventTypeHeader: "comment.created"}},
+		{ID: 2, Topic: "votes", Key: "1", Payload: []byte("{}")},
+	}}
+	prod := mocks.NewSyncProducer(t, nil)
+	prod.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		require.Equal(t, "comments.comment-events", msg.Topic, "Routed topic mismatch")
+		carrier := HeadersCarrier(msg.Headers)
+		require.Equal(t, "comment.created", carrier.Get(EventTypeHeader), "Event type mismatch")
+		return nil
+	})
+	prod.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		require.Equal(t, "votes", msg.Topic, "Unrouted topic mismatch")
+		return nil
+	})
+	p := NewPublisher(rep, &producerStub{prod: prod}, Config{BatchSize: 10, Routes: map[string]string{"comment-events": "comments.comment-events"}})
+	p.Publish(context.Background())
+	require.Equal(t, []int64{1, 2}, rep.sent, "Sent messages mismatch")
+	require.NoError(t, prod.Close())
+}
+
+func TestPublisherStopsOnFailure(t *testing.T) {
+	rep := &repositoryStub{msgs: []Message{
+		{ID: 1, Topic: "comment-events", Key: "1", Payload: []byte("{}")},
+		{ID: 2, Topic: "comment-events", Key: "1", Payload: []byte("{}")},
+		{ID: 3, Topic: "comment-events", Key: "1", Payload: []byte("{}")},
+	}}
+	prod := mocks.NewSyncProducer(t, nil)
+	prod.ExpectSendMessageAndSucceed()
+	prod.ExpectSendMessageAndFail(errors.New("broker is not available"))
+	producers := &producerStub{prod: prod}
+	p := NewPublisher(rep, producers, Config{BatchSize: 10})
+	p.Publish(context.Background())
+	require.Equal(t, []int64{1}, rep.sent, "Sent messages mismatch")
+	require.Equal(t, 1, len(producers.failed), "Failures reported mismatch")
+	require.NoError(t, prod.Close())
+}
+
+func TestPublisherMarksSentOnTimeout(t *testing.T) {
+	rep := &repositoryStub{msgs: []Message{
+		{ID: 1, Topic: "comment-events", Key: "1", Payload: []byte("{}")},
+		{ID: 2, Topic: "comment-events", Key: "1", Payload: []byte("{}")},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	prod := mocks.NewSyncProducer(t, nil)
+	prod.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(_ *sarama.ProducerMessage) error {
+		cancel()
+		return nil
+	})
+	p := NewPublisher(rep, &producerStub{prod: prod}, Config{BatchSize: 10, Lease: time.Minute})
+	p.Publish(ctx)
+	require.Equal(t, []int64{1}, rep.sent, "Sent messages mismatch")
+	require.WithinDuration(t, time.Now().Add(time.Minute), rep.leaseUntil, time.Second, "Lease mismatch")
+	require.NoError(t, prod.Close())
+}
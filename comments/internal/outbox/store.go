@@ -0,0 +1,146 @@
+package outbox
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Store struct {
+	db DBTX
+}
+
+func NewStore(db DBTX) *Store {
+	return &Store{db: db}
+}
+
+const claimPendingMessages = `UPDATE outbox
+SET claimed_until = $2
+WHERE id IN (SELECT id
+             FROM outbox o
+             WHERE status = 'new'
+               AND (claimed_until IS NULL OR claimed_until < now())
+               AND NOT EXISTS (SELECT 1
+                               FROM outbox p
+                               WHERE p.status = 'new'
+                                 AND p.key = o.key
+                                 AND p.id < o.id
+                                 AND p.claimed_until >= now())
+             ORDER BY id
+             LIMIT $1 FOR UPDATE SKIP LOCKED)
+RETURNING id, topic, key, payload, headers, created_at, status, sent_at
+`
+
+// ClaimPendingMessages leases unsent messages until leaseUntil and returns them in the order
+// they were enqueued, so several publishers do not send the same message. A message is not
+// claimed while an earlier message with the same key is leased by another publisher.
+func (s *Store) ClaimPendingMessages(ctx context.Context, limit int32, leaseUntil time.Time) ([]Message, error) {
+	rows, err := s.db.Query(ctx, claimPendingMessages, limit, leaseUntil)
+	if err != nil {
+		return nil, fmt.Errorf("can not claim outbox messages: %w", err)
+	}
+	items, err := scanMessages(rows)
+	if err != nil {
+		return nil, err
+	}
+	slices.SortFunc(items, func(a, b Message) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return items, nil
+}
+
+// Filter selects messages of the outbox, zero fields are not applied.
+type Filter struct {
+	Status string
+	Topic  string
+	FromID int64
+	ToID   int64
+	Limit  int32
+}
+
+const getMessages = `SELECT id, topic, key, payload, headers, created_at, status, sent_at
+FROM outbox
+WHERE id >= $1
+  AND ($2::bigint IS NULL OR id <= $2)
+  AND ($3::text IS NULL OR status = $3)
+  AND ($4::text IS NULL OR topic = $4)
+ORDER BY id
+LIMIT $5
+`
+
+// GetMessages returns messages of any status ordered by id.
+func (s *Store) GetMessages(ctx context.Context, filter Filter) ([]Message, error) {
+	var (
+		toID          *int64
+		status, topic *string
+	)
+	if filter.ToID != 0 {
+		toID = &filter.ToID
+	}
+	if filter.Status != "" {
+		status = &filter.Status
+	}
+	if filter.Topic != "" {
+		topic = &filter.Topic
+	}
+	rows, err := s.db.Query(ctx, getMessages, filter.FromID, toID, status, topic, filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("can not get outbox messages: %w", err)
+	}
+	return scanMessages(rows)
+}
+
+func scanMessages(rows pgx.Rows) ([]Message, error) {
+	defer rows.Close()
+	var items []Message
+	for rows.Next() {
+		var (
+			i         Message
+			headers   []byte
+			createdAt pgtype.Timestamp
+			sentAt    pgtype.Timestamp
+		)
+		if err := rows.Scan(
+			&i.ID,
+			&i.Topic,
+			&i.Key,
+			&i.Payload,
+			&headers,
+			&createdAt,
+			&i.Status,
+			&sentAt,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(headers, &i.Headers); err != nil {
+			return nil, fmt.Errorf("unmarshal outbox headers failed: %w", err)
+		}
+		i.CreatedTS = createdAt.Time
+		i.SentTS = sentAt.Time
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markMessagesSent = `UPDATE outbox
+SET status  = 'send',
+    sent_at = now()
+WHERE id = ANY ($1::bigint[])
+`
+
+func (s *Store) MarkMessagesSent(ctx context.Context, ids []int64) error {
+	_, err := s.db.Exec(ctx, markMessagesSent, ids)
+	if err != nil {
+		return fmt.Errorf("can not mark outbox messages as sent: %w", err)
+	}
+	return nil
+}
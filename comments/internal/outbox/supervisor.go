@@ -0,0 +1,167 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+var ErrProducerNotConnected = errors.New("kafka producer is not connected")
+
+// ProducerSource provides the producer of a supervised connection.
+type ProducerSource interface {
+	// Producer returns the current producer or the reason it is not available.
+	Producer() (sarama.SyncProducer, error)
+	// Failed reports an error returned by the producer.
+	Failed(prod sarama.SyncProducer, err error)
+}
+
+// HealthReporter receives the state of the producer, nil err means connected.
+type HealthReporter interface {
+	Set(component string, err error)
+}
+
+type BackoffConfig struct {
+	Initial time.Duration
+	Max     time.Duration
+}
+
+var _ ProducerSource = (*ProducerSupervisor)(nil)
+
+// ProducerSupervisor keeps a producer connected. The producer is created with exponential
+// backoff and recreated when it fails in a way it can not recover from by itself, so the
+// outbox is drained again as soon as Kafka is back.
+type ProducerSupervisor struct {
+	name    string
+	connect func() (sarama.SyncProducer, error)
+	backoff BackoffConfig
+	health  HealthReporter
+	mu      sync.RWMutex
+	prod    sarama.SyncProducer
+	err     error
+	resetCh chan sarama.SyncProducer
+}
+
+func NewProducerSupervisor(name string, connect func() (sarama.SyncProducer, error), backoff BackoffConfig,
+	health HealthReporter) *ProducerSupervisor {
+	return &ProducerSupervisor{
+		name:    name,
+		connect: connect,
+		backoff: backoff,
+		health:  health,
+		err:     ErrProducerNotConnected,
+		resetCh: make(chan sarama.SyncProducer, 1),
+	}
+}
+
+// Start connects in the background and closes the producer when ctx is done.
+func (s *ProducerSupervisor) Start(ctx context.Context) {
+	s.report(ErrProducerNotConnected)
+	go s.run(ctx)
+}
+
+func (s *ProducerSupervisor) run(ctx context.Context) {
+	for {
+		if !s.connectWithBackoff(ctx) {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			s.mu.Lock()
+			prod := s.prod
+			s.prod, s.err = nil, ErrProducerNotConnected
+			s.mu.Unlock()
+			if prod != nil {
+				_ = prod.Close()
+			}
+			select {
+			case prod = <-s.resetCh:
+				_ = prod.Close()
+			default:
+			}
+			logger.Infow(ctx, "kafka producer closed", "producer", s.name)
+			return
+		case prod := <-s.resetCh:
+			_ = prod.Close()
+		}
+	}
+}
+
+func (s *ProducerSupervisor) connectWithBackoff(ctx context.Context) bool {
+	backoff := s.backoff.Initial
+	for attempt := 1; ; attempt++ {
+		prod, err := s.connect()
+		if err == nil {
+			metrics.IncProducerConnects(s.name, metrics.ResultSuccess)
+			s.mu.Lock()
+			s.prod, s.err = prod, nil
+			s.mu.Unlock()
+			s.report(nil)
+			logger.Infow(ctx, "kafka producer connected", "producer", s.name, "attempt", attempt)
+			return true
+		}
+		metrics.IncProducerConnects(s.name, metrics.ResultError)
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+		s.report(err)
+		logger.Warnw(ctx, "kafka producer connect failed",
+			"producer", s.name,
+			"attempt", attempt,
+			"retry_in", backoff.String(),
+			"error", err.Error())
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, s.backoff.Max)
+	}
+}
+
+func (s *ProducerSupervisor) Producer() (sarama.SyncProducer, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.prod == nil {
+		return nil, s.err
+	}
+	return s.prod, nil
+}
+
+// Failed recreates prod when err means it is closed, has lost all brokers or its transaction
+// manager is in a fatal state. Other errors are retried by the next outbox batch.
+func (s *ProducerSupervisor) Failed(prod sarama.SyncProducer, err error) {
+	if !isFatal(prod, err) {
+		return
+	}
+	s.mu.Lock()
+	if s.prod != prod {
+		s.mu.Unlock()
+		return
+	}
+	s.prod, s.err = nil, err
+	s.mu.Unlock()
+	s.report(err)
+	logger.Warnw(context.Background(), "kafka producer failed, reconnecting", "producer", s.name, "error", err.Error())
+	s.resetCh <- prod
+}
+
+func (s *ProducerSupervisor) report(err error) {
+	metrics.SetProducerUp(s.name, err == nil)
+	if s.health != nil {
+		s.health.Set("kafka_producer_"+s.name, err)
+	}
+}
+
+func isFatal(prod sarama.SyncProducer, err error) bool {
+	if errors.Is(err, sarama.ErrClosedClient) || errors.Is(err, sarama.ErrOutOfBrokers) ||
+		errors.Is(err, sarama.ErrNotConnected) {
+		return true
+	}
+	return prod.TxnStatus()&sarama.ProducerTxnFlagFatalError != 0
+}
@@ -0,0 +1,75 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+type healthStub struct {
+	mu    sync.Mutex
+	state map[string]error
+}
+
+func (h *healthStub) Set(component string, err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.state[component] = err
+}
+
+func (h *healthStub) Get(component string) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.state[component]
+}
+
+func TestProducerSupervisorReconnects(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	connect := func() (sarama.SyncProducer, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts%3 != 0 {
+			return nil, sarama.ErrOutOfBrokers
+		}
+		return mocks.NewSyncProducer(t, nil), nil
+	}
+	health := &healthStub{state: make(map[string]error)}
+	s := NewProducerSupervisor("test", connect, BackoffConfig{Initial: time.Millisecond, Max: 4 * time.Millisecond}, health)
+	s.Start(ctx)
+
+	var prod sarama.SyncProducer
+	require.Eventually(t, func() bool {
+		var err error
+		prod, err = s.Producer()
+		return err == nil
+	}, time.Second, time.Millisecond, "Producer is not connected")
+	require.NoError(t, health.Get("kafka_producer_test"), "Health mismatch after connect")
+
+	s.Failed(prod, errors.New("message too large"))
+	current, err := s.Producer()
+	require.NoError(t, err, "Producer reset on a recoverable error")
+	require.Equal(t, prod, current, "Producer replaced on a recoverable error")
+
+	s.Failed(prod, sarama.ErrOutOfBrokers)
+	_, err = s.Producer()
+	require.ErrorIs(t, err, sarama.ErrOutOfBrokers, "Producer is not reset")
+	require.Eventually(t, func() bool {
+		current, err = s.Producer()
+		return err == nil && current != prod
+	}, time.Second, time.Millisecond, "Producer is not reconnected")
+	mu.Lock()
+	require.Equal(t, 6, attempts, "Connect attempts mismatch")
+	mu.Unlock()
+}
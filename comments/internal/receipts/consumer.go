@@ -0,0 +1,44 @@
+package receipts
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example/comments/internal/app/config"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"fmt"
+
+	"github.com/IBM/sarama"
+)
+
+// StartConsumer consumes delivery receipts until ctx is canceled.
+func StartConsumer(ctx context.Context, kafkaConf notification.KafkaConfig, topic string, groupID string,
+	conf config.ConsumerConf, service *Service) error {
+	return consumer.Start(ctx, kafkaConf, consumer.NewConfig(groupID, []string{topic}, conf), service.handle)
+}
+
+// handle decodes the receipt, malformed receipts fail permanently.
+func (s *Service) handle(ctx context.Context, msg *sarama.ConsumerMessage) error {
+	receipt := notification.DeliveryReceipt{}
+	if err := json.Unmarshal(msg.Value, &receipt); err != nil {
+		return consumer.Permanent(fmt.Errorf("malformed receipt: %w", err))
+	}
+	if err := validate(receipt); err != nil {
+		return consumer.Permanent(err)
+	}
+	if receipt.Time.IsZero() {
+		receipt.Time = msg.Timestamp
+	}
+	return s.Handle(ctx, receipt)
+}
+
+func validate(receipt notification.DeliveryReceipt) error {
+	if len(receipt.NotificationIDs) == 0 {
+		return errors.New("malformed receipt: no notification ids")
+	}
+	if receipt.State != notification.StateDelivered && receipt.State != notification.StateRead {
+		return fmt.Errorf("malformed receipt: unknown delivery state: %s", receipt.State)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package receipts
+
+import (
+	"context"
+	"example/comments/internal/consumer"
+	"example/comments/internal/external/notification"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+)
+
+type repStub struct {
+	ids []int64
+	at  time.Time
+}
+
+func (r *repStub) MarkNotificationsDelivered(_ context.Context, ids []int64, at time.Time) ([]time.Time, error) {
+	r.ids, r.at = ids, at
+	return nil, nil
+}
+
+func (r *repStub) MarkNotificationsRead(_ context.Context, ids []int64, at time.Time) ([]time.Time, error) {
+	r.ids, r.at = ids, at
+	return nil, nil
+}
+
+func TestHandleMalformedReceipts(t *testing.T) {
+	service := NewService(&repStub{})
+	for _, value := range []string{`{`, `{"state":"delivered"}`, `{"notification_ids":[1],"state":"lost"}`} {
+		err := service.handle(context.Background(), &sarama.ConsumerMessage{Value: []byte(value)})
+		require.True(t, consumer.IsPermanent(err), "Error of %s is not permanent", value)
+	}
+}
+
+func TestHandleReceiptTime(t *testing.T) {
+	rep := &repStub{}
+	service := NewService(rep)
+	ts := time.Date(2025, 3, 14, 15, 9, 0, 0, time.UTC)
+	msg := &sarama.ConsumerMessage{Value: []byte(`{"notification_ids":[1,2],"state":"` + notification.StateRead + `"}`), Timestamp: ts}
+	require.NoError(t, service.handle(context.Background(), msg), "handle failed")
+	require.Equal(t, []int64{1, 2}, rep.ids, "Notifications mismatch")
+	require.Equal(t, ts, rep.at, "Time mismatch")
+}
@@ -0,0 +1,49 @@
+package receipts
+
+import (
+	"context"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
+	"fmt"
+	"time"
+)
+
+type ReceiptRepository interface {
+	MarkNotificationsDelivered(ctx context.Context, notificationIDs []int64, at time.Time) ([]time.Time, error)
+	MarkNotificationsRead(ctx context.Context, notificationIDs []int64, at time.Time) ([]time.Time, error)
+}
+
+type Service struct {
+	rep ReceiptRepository
+}
+
+func NewService(rep ReceiptRepository) *Service {
+	return &Service{
+		rep: rep,
+	}
+}
+
+// Handle applies a delivery receipt to the outbox. States only move forward, so receipts
+// may be redelivered or arrive out of order.
+func (s *Service) Handle(ctx context.Context, receipt notification.DeliveryReceipt) error {
+	var created []time.Time
+	var err error
+	switch receipt.State {
+	case notification.StateDelivered:
+		created, err = s.rep.MarkNotificationsDelivered(ctx, receipt.NotificationIDs, receipt.Time)
+	case notification.StateRead:
+		created, err = s.rep.MarkNotificationsRead(ctx, receipt.NotificationIDs, receipt.Time)
+	default:
+		return fmt.Errorf("unknown delivery state: %s", receipt.State)
+	}
+	if err != nil {
+		return err
+	}
+	for _, createdTS := range created {
+		metrics.ObserveNotificationLatency(receipt.State, createdTS, receipt.Time)
+	}
+	logger.Infow(ctx, "delivery receipt applied", "state", receipt.State,
+		"notifications", len(receipt.NotificationIDs), "updated", len(created))
+	return nil
+}
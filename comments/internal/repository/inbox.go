@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/model"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// StartEvent records that the consumer is processing the event and returns false when the
+// event was already processed. An event left in processing by a failed or interrupted attempt
+// is started again, so the consumer processes every event at least once. The record is
+// committed before processing, no transaction stays open while the event is processed.
+func (rep *Repository) StartEvent(ctx context.Context, consumer string, eventID string) (bool, error) {
+	started, err := New(rep.write).StartProcessingEvent(ctx, &StartProcessingEventParams{
+		Consumer: consumer,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("start processing event failed: %w", err)
+	}
+	return started > 0, nil
+}
+
+// CompleteEvent marks the event processed, later deliveries of it are skipped.
+func (rep *Repository) CompleteEvent(ctx context.Context, consumer string, eventID string) error {
+	err := New(rep.write).MarkEventProcessed(ctx, &MarkEventProcessedParams{
+		Consumer: consumer,
+		EventID:  eventID,
+	})
+	if err != nil {
+		return fmt.Errorf("mark event processed failed: %w", err)
+	}
+	return nil
+}
+
+func (rep *Repository) GetNotificationChannel(ctx context.Context, ownerID int64) (model.NotificationChannel, error) {
+	r := New(rep.write)
+	channel, err := r.GetNotificationChannel(ctx, ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.NotificationChannel{}, model.ErrNotificationChannelNotFound
+	}
+	if err != nil {
+		return model.NotificationChannel{}, fmt.Errorf("can not get notification channel: %w", err)
+	}
+	return model.NotificationChannel{
+		OwnerID: channel.OwnerID,
+		Kind:    channel.Channel,
+		Address: channel.Address,
+		Locale:  channel.Locale,
+		Secret:  channel.Secret,
+	}, nil
+}
+
+// SaveNotificationChannel replaces the channel the notifier delivers the owner notifications to.
+func (rep *Repository) SaveNotificationChannel(ctx context.Context, channel model.NotificationChannel) error {
+	r := New(rep.write)
+	err := r.SaveNotificationChannel(ctx, &SaveNotificationChannelParams{
+		OwnerID: channel.OwnerID,
+		Channel: channel.Kind,
+		Address: channel.Address,
+		Locale:  channel.Locale,
+		Secret:  channel.Secret,
+	})
+	if err != nil {
+		return fmt.Errorf("can not save notification channel: %w", err)
+	}
+	return nil
+}
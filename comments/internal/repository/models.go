@@ -8,10 +8,65 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type Comment struct {
+	ID                 int64
+	UserID             int64
+	ProductID          int64
+	Tx                 string
+	Ts                 pgtype.Timestamp
+	ParentID           *int64
+	ProductDeleted     bool
+	AuthorBanned       bool
+	Status             string
+	ValidationAttempts int32
+	ValidateAt         pgtype.Timestamp
+	RejectReason       *string
+}
+
+type NotificationChannel struct {
+	OwnerID int64
+	Channel string
+	Address string
+	Locale  string
+	Secret  string
+}
+
+type NotificationPreference struct {
+	OwnerID         int64
+	Mode            string
+	MutedProducts   []int64
+	QuietHoursStart *int16
+	QuietHoursEnd   *int16
+	NextDigestAt    pgtype.Timestamp
+	UpdatedAt       pgtype.Timestamp
+	Timezone        string
+}
+
 type OutboxNotification struct {
+	ID            int64
+	RecipientID   int64
+	CommentID     int64
+	Ts            pgtype.Timestamp
+	Status        string
+	TraceParent   *string
+	WebhookStatus string
+	ProductID     *int64
+	Reason        string
+	SentAt        pgtype.Timestamp
+	DeliveredAt   pgtype.Timestamp
+	ReadAt        pgtype.Timestamp
+}
+
+type ProductSubscription struct {
+	UserID    int64
+	ProductID int64
+	CreatedAt pgtype.Timestamp
+}
+
+type Webhook struct {
 	ID        int64
 	OwnerID   int64
-	CommentID int64
-	Ts        pgtype.Timestamp
-	Status    string
+	Url       string
+	Secret    string
+	CreatedAt pgtype.Timestamp
 }
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"example/comments/internal/outbox"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// enqueueCommentEvent saves the event within tx, events of one product are keyed together
+// and published in order.
+func enqueueCommentEvent(ctx context.Context, tx DBTX, eventType string, event model.CommentEvent) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("marshal comment event failed: %w", err)
+	}
+	_, err = outbox.Enqueue(ctx, tx, model.TopicCommentEvents, strconv.FormatInt(event.ProductID, 10), payload,
+		map[string]string{outbox.EventTypeHeader: eventType})
+	return err
+}
+
+// enqueueNotification saves the notification payload within tx, notifications of one recipient
+// are keyed together and published in order. The key is the former owner_id partition key:
+// owner_id was the recipient column before notifications got reasons, so the notifications of
+// a product owner about new comments keep their key, and replies, mentions and digests are
+// ordered per the user who receives them rather than per the owner of the commented product.
+func enqueueNotification(ctx context.Context, tx DBTX, eventType string, recipientID int64, payload any) error {
+	bytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("marshal notification failed: %w", err)
+	}
+	_, err = outbox.Enqueue(ctx, tx, notification.TopicNotifications, strconv.FormatInt(recipientID, 10), bytes,
+		map[string]string{outbox.EventTypeHeader: eventType})
+	return err
+}
+
+func (rep *Repository) ClaimPendingMessages(ctx context.Context, limit int32, leaseUntil time.Time) ([]outbox.Message, error) {
+	return outbox.NewStore(rep.write).ClaimPendingMessages(ctx, limit, leaseUntil)
+}
+
+func (rep *Repository) MarkMessagesSent(ctx context.Context, ids []int64) error {
+	return outbox.NewStore(rep.write).MarkMessagesSent(ctx, ids)
+}
@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"example/comments/internal/outbox"
+	"example/comments/internal/trace"
+	"fmt"
+	"strconv"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func (rep *Repository) GetOutboxRecords(ctx context.Context, filter model.OutboxFilter) ([]model.OutboxRecord, error) {
+	return getOutboxRecords(ctx, New(rep.write), filter)
+}
+
+// ReplayNotifications enqueues the selected notifications to the logical topic of the outbox,
+// the notifications topic when empty, as comment.created events, keeping the trace of the
+// original comment. Notification statuses are not changed, preferences are not applied.
+// With dryRun nothing is enqueued.
+func (rep *Repository) ReplayNotifications(ctx context.Context, filter model.OutboxFilter, topic string, dryRun bool) ([]model.OutboxRecord, error) {
+	if topic == "" {
+		topic = notification.TopicNotifications
+	}
+	var records []model.OutboxRecord
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		records, err = getOutboxRecords(ctx, New(tx), filter)
+		if err != nil || dryRun {
+			return err
+		}
+		for _, val := range records {
+			_, err = outbox.Enqueue(trace.ContextWithTraceParent(ctx, val.TraceParent), tx, topic,
+				strconv.FormatInt(val.RecipientID, 10), val.Payload,
+				map[string]string{outbox.EventTypeHeader: notification.EventCommentCreated})
+			if err != nil {
+				return fmt.Errorf("replay notification %d failed: %w", val.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (rep *Repository) GetOutboxMessages(ctx context.Context, filter model.OutboxMessageFilter) ([]model.OutboxMessage, error) {
+	return getOutboxMessages(ctx, rep.write, filter)
+}
+
+// ReplayOutboxMessages enqueues copies of the selected messages of the generic outbox with
+// their topics, keys and headers, keeping the trace they were enqueued with. The original
+// messages are not changed. With dryRun nothing is enqueued.
+func (rep *Repository) ReplayOutboxMessages(ctx context.Context, filter model.OutboxMessageFilter, dryRun bool) ([]model.OutboxMessage, error) {
+	var msgs []model.OutboxMessage
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var err error
+		msgs, err = getOutboxMessages(ctx, tx, filter)
+		if err != nil || dryRun {
+			return err
+		}
+		for _, val := range msgs {
+			msgCtx := trace.Propagator().Extract(ctx, propagation.MapCarrier(val.Headers))
+			if _, err = outbox.Enqueue(msgCtx, tx, val.Topic, val.Key, val.Payload, val.Headers); err != nil {
+				return fmt.Errorf("replay outbox message %d failed: %w", val.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return msgs, nil
+}
+
+func getOutboxMessages(ctx context.Context, db DBTX, filter model.OutboxMessageFilter) ([]model.OutboxMessage, error) {
+	msgs, err := outbox.NewStore(db).GetMessages(ctx, outbox.Filter{
+		Status: filter.Status,
+		Topic:  filter.Topic,
+		FromID: filter.FromID,
+		ToID:   filter.ToID,
+		Limit:  filter.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make([]model.OutboxMessage, len(msgs))
+	for i, val := range msgs {
+		res[i] = model.OutboxMessage{
+			ID:        val.ID,
+			Topic:     val.Topic,
+			Key:       val.Key,
+			Headers:   val.Headers,
+			Status:    val.Status,
+			CreatedAt: val.CreatedTS,
+			SentAt:    val.SentTS,
+			Payload:   val.Payload,
+		}
+	}
+	return res, nil
+}
+
+func getOutboxRecords(ctx context.Context, r *Queries, filter model.OutboxFilter) ([]model.OutboxRecord, error) {
+	params := &GetOutboxNotificationsParams{
+		FromID:   filter.FromID,
+		MaxCount: filter.Limit,
+	}
+	if filter.ToID != 0 {
+		params.ToID = &filter.ToID
+	}
+	if filter.Status != "" {
+		params.Status = &filter.Status
+	}
+	if !filter.From.IsZero() {
+		params.FromTs = pgtype.Timestamp{Time: filter.From, Valid: true}
+	}
+	if !filter.To.IsZero() {
+		params.ToTs = pgtype.Timestamp{Time: filter.To, Valid: true}
+	}
+	ntfs, err := r.GetOutboxNotifications(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("can not get outbox notifications: %w", err)
+	}
+	res := make([]model.OutboxRecord, len(ntfs))
+	for i, val := range ntfs {
+		ntf := notification.CommentNotification{
+			ID:          val.ID,
+			RecipientID: val.RecipientID,
+			Reason:      val.Reason,
+			CommentID:   val.CommentID,
+			CreatedTS:   val.Ts.Time,
+		}
+		if val.ProductID != nil {
+			ntf.ProductID = *val.ProductID
+		}
+		payload, err := json.Marshal(ntf)
+		if err != nil {
+			return nil, fmt.Errorf("marshal notification failed: %w", err)
+		}
+		res[i] = model.OutboxRecord{
+			ID:          val.ID,
+			CommentID:   val.CommentID,
+			ProductID:   ntf.ProductID,
+			RecipientID: val.RecipientID,
+			Reason:      val.Reason,
+			Status:      val.Status,
+			CreatedAt:   val.Ts.Time,
+			SentAt:      val.SentAt.Time,
+			Payload:     payload,
+		}
+		if val.TraceParent != nil {
+			res[i].TraceParent = *val.TraceParent
+		}
+	}
+	return res, nil
+}
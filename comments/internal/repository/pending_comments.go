@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SavePendingComment saves the comment accepted in degraded mode. It is hidden from
+// GetComments and nobody is notified until the validator publishes it after validateAt.
+func (rep *Repository) SavePendingComment(ctx context.Context, comment model.Comment, validateAt time.Time) (int64, error) {
+	r := New(rep.write)
+	params := saveCommentParams(comment, time.Now(), model.CommentPendingValidation)
+	params.ValidateAt = pgtype.Timestamp{
+		Time:  validateAt,
+		Valid: true,
+	}
+	commentID, err := r.SaveComment(ctx, params)
+	if err != nil {
+		return 0, fmt.Errorf("save pending comment failed: %w", err)
+	}
+	return commentID, nil
+}
+
+// GetPendingComments returns the pending comments due for validation, the oldest first.
+func (rep *Repository) GetPendingComments(ctx context.Context, limit int) ([]model.Comment, error) {
+	r := New(rep.write)
+	comments, err := r.GetPendingComments(ctx, int32(limit))
+	if err != nil {
+		return nil, fmt.Errorf("can not get pending comments: %w", err)
+	}
+	res := make([]model.Comment, len(comments))
+	for i, val := range comments {
+		res[i] = model.Comment{
+			ID:                 val.ID,
+			UserID:             val.UserID,
+			ProductID:          val.ProductID,
+			Text:               val.Tx,
+			Ts:                 val.Ts.Time,
+			Status:             model.CommentPendingValidation,
+			ValidationAttempts: int(val.ValidationAttempts),
+		}
+		if val.ParentID != nil {
+			res[i].ParentID = *val.ParentID
+		}
+	}
+	return res, nil
+}
+
+// PublishPendingComment makes the validated comment visible and notifies its recipients as
+// SaveComment does. It reports false if the comment is no longer pending, for example it was
+// handled by another instance.
+func (rep *Repository) PublishPendingComment(ctx context.Context, comment model.Comment) (bool, error) {
+	published := false
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		count, err := New(tx).PublishPendingComment(ctx, comment.ID)
+		if err != nil {
+			return fmt.Errorf("publish pending comment failed: %w", err)
+		}
+		if count == 0 {
+			return nil
+		}
+		published = true
+		return rep.publishComment(ctx, tx, comment)
+	})
+	return published, err
+}
+
+// RejectPendingComment rejects the comment and notifies its author in the same transaction.
+// It reports false if the comment is no longer pending.
+func (rep *Repository) RejectPendingComment(ctx context.Context, comment model.Comment, reason string) (bool, error) {
+	rejected := false
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		count, err := r.RejectPendingComment(ctx, &RejectPendingCommentParams{
+			ID:           comment.ID,
+			RejectReason: &reason,
+		})
+		if err != nil {
+			return fmt.Errorf("reject pending comment failed: %w", err)
+		}
+		if count == 0 {
+			return nil
+		}
+		rejected = true
+		author := model.Recipient{UserID: comment.UserID, Reason: model.ReasonCommentRejected}
+		if err = saveRecipientNotification(ctx, r, author, comment.ProductID, comment.ID, time.Now()); err != nil {
+			return fmt.Errorf("save rejection ntf failed: %w", err)
+		}
+		return nil
+	})
+	return rejected, err
+}
+
+// PostponeCommentValidation counts the failed attempt and moves the next one to validateAt.
+func (rep *Repository) PostponeCommentValidation(ctx context.Context, commentID int64, validateAt time.Time) error {
+	r := New(rep.write)
+	err := r.PostponeCommentValidation(ctx, &PostponeCommentValidationParams{
+		ID: commentID,
+		ValidateAt: pgtype.Timestamp{
+			Time:  validateAt,
+			Valid: true,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("postpone comment validation failed: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// digestMaxCount bounds a single digest, the rest is summarized in the next period.
+const digestMaxCount = 1000
+
+func (rep *Repository) SaveNotificationPreferences(ctx context.Context, prefs model.NotificationPreferences) (model.NotificationPreferences, error) {
+	r := New(rep.write)
+	params := &SaveNotificationPreferencesParams{
+		OwnerID:       prefs.OwnerID,
+		Mode:          prefs.Mode,
+		MutedProducts: prefs.MutedProductIDs,
+		Timezone:      prefs.Timezone,
+		NextDigestAt: pgtype.Timestamp{
+			Time:  prefs.NextDigestAt,
+			Valid: !prefs.NextDigestAt.IsZero(),
+		},
+	}
+	if params.MutedProducts == nil {
+		params.MutedProducts = []int64{}
+	}
+	if prefs.QuietHours != nil {
+		start, end := int16(prefs.QuietHours.Start), int16(prefs.QuietHours.End)
+		params.QuietHoursStart = &start
+		params.QuietHoursEnd = &end
+	}
+	saved, err := r.SaveNotificationPreferences(ctx, params)
+	if err != nil {
+		return model.NotificationPreferences{}, fmt.Errorf("save notification preferences failed: %w", err)
+	}
+	return toNotificationPreferences(saved), nil
+}
+
+func (rep *Repository) GetNotificationPreferences(ctx context.Context, ownerID int64) (model.NotificationPreferences, error) {
+	r := New(rep.write)
+	prefs, err := r.GetNotificationPreferences(ctx, ownerID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.NotificationPreferences{}, model.ErrNotificationPreferencesNotFound
+	}
+	if err != nil {
+		return model.NotificationPreferences{}, fmt.Errorf("can not get notification preferences: %w", err)
+	}
+	return toNotificationPreferences(prefs), nil
+}
+
+// ClaimDueDigests returns the owners whose digest period is over and who are not in their quiet
+// hours. The claimed digests are moved to leaseUntil, so overlapping ticks and other replicas skip
+// them and a digest that failed to complete is retried once the lease is over.
+func (rep *Repository) ClaimDueDigests(ctx context.Context, now time.Time, leaseUntil time.Time) ([]model.NotificationPreferences, error) {
+	r := New(rep.write)
+	prefs, err := r.ClaimDueDigestPreferences(ctx, &ClaimDueDigestPreferencesParams{
+		LeaseUntil: pgtype.Timestamp{
+			Time:  leaseUntil.UTC(),
+			Valid: true,
+		},
+		DueAt: pgtype.Timestamp{
+			Time:  now.UTC(),
+			Valid: true,
+		},
+		Now: pgtype.Timestamptz{
+			Time:  now,
+			Valid: true,
+		},
+		MaxCount: rep.ntfCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not claim due digests: %w", err)
+	}
+	res := make([]model.NotificationPreferences, len(prefs))
+	for i, val := range prefs {
+		res[i] = toNotificationPreferences(val)
+	}
+	return res, nil
+}
+
+func (rep *Repository) GetRecipientNotification(ctx context.Context, recipientID int64) ([]notification.CommentNotification, error) {
+	r := New(rep.write)
+	ntfsEntity, err := r.GetRecipientUnSendNotification(ctx, &GetRecipientUnSendNotificationParams{
+		RecipientID: recipientID,
+		Limit:       digestMaxCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not get recipient notifications: %w", err)
+	}
+	ntfs := make([]notification.CommentNotification, len(ntfsEntity))
+	for i, val := range ntfsEntity {
+		ntfs[i] = notification.CommentNotification{
+			ID:          val.ID,
+			RecipientID: val.RecipientID,
+			Reason:      val.Reason,
+			CommentID:   val.CommentID,
+			CreatedTS:   val.Ts.Time,
+		}
+		if val.TraceParent != nil {
+			ntfs[i].TraceParent = *val.TraceParent
+		}
+		if val.ProductID != nil {
+			ntfs[i].ProductID = *val.ProductID
+		}
+	}
+	return ntfs, nil
+}
+
+// CompleteDigest enqueues the digest to the outbox, marks the summarized notifications as sent,
+// replaces their webhooks with one digest webhook and schedules the next digest. The digest is
+// nil if nothing was accumulated.
+func (rep *Repository) CompleteDigest(ctx context.Context, ownerID int64, digest *notification.DigestNotification, nextDigestAt time.Time) error {
+	return pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		if digest != nil {
+			err := enqueueNotification(ctx, tx, notification.EventCommentDigest, digest.RecipientID, digest)
+			if err != nil {
+				return fmt.Errorf("enqueue digest failed: %w", err)
+			}
+			if err := r.MarkNotificationsAsSend(ctx, digest.NotificationIDs); err != nil {
+				return fmt.Errorf("mark digest notifications failed: %w", err)
+			}
+			if err := r.MarkWebhookNotificationsDone(ctx, digest.NotificationIDs); err != nil {
+				return fmt.Errorf("mark digest webhook notifications failed: %w", err)
+			}
+			payload, err := json.Marshal(digest)
+			if err != nil {
+				return fmt.Errorf("marshal digest failed: %w", err)
+			}
+			lastID := digest.NotificationIDs[len(digest.NotificationIDs)-1]
+			err = scheduleWebhookTasks(ctx, r, ownerID, lastID, notification.EventCommentDigest, payload, "")
+			if err != nil {
+				return err
+			}
+		}
+		err := r.UpdateNextDigest(ctx, &UpdateNextDigestParams{
+			OwnerID: ownerID,
+			NextDigestAt: pgtype.Timestamp{
+				Time:  nextDigestAt,
+				Valid: true,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("update next digest failed: %w", err)
+		}
+		return nil
+	})
+}
+
+func toNotificationPreferences(prefs *NotificationPreference) model.NotificationPreferences {
+	res := model.NotificationPreferences{
+		OwnerID:         prefs.OwnerID,
+		Mode:            prefs.Mode,
+		MutedProductIDs: prefs.MutedProducts,
+		Timezone:        prefs.Timezone,
+		NextDigestAt:    prefs.NextDigestAt.Time,
+	}
+	if prefs.QuietHoursStart != nil && prefs.QuietHoursEnd != nil {
+		res.QuietHours = &model.QuietHours{
+			Start: int(*prefs.QuietHoursStart),
+			End:   int(*prefs.QuietHoursEnd),
+		}
+	}
+	return res
+}
@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SetProductDeleted hides the comments of a deleted product or shows them again on restore.
+// The event is recorded in the inbox in the same transaction, a redelivered event changes
+// nothing and returns false.
+func (rep *Repository) SetProductDeleted(ctx context.Context, consumer string, eventID string,
+	productID int64, deleted bool) (bool, int64, error) {
+	processed := false
+	changed := int64(0)
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		inserted, err := r.SaveProcessedEvent(ctx, &SaveProcessedEventParams{
+			Consumer: consumer,
+			EventID:  eventID,
+		})
+		if err != nil {
+			return fmt.Errorf("save processed event failed: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+		changed, err = r.SetProductCommentsDeleted(ctx, &SetProductCommentsDeletedParams{
+			ProductID:      productID,
+			ProductDeleted: deleted,
+		})
+		if err != nil {
+			return fmt.Errorf("set product comments deleted failed: %w", err)
+		}
+		processed = true
+		return nil
+	})
+	return processed, changed, err
+}
+
+// ChangeProductOwner moves the pending new comment notifications of the product to the new
+// owner and recomputes the owner answer reasons of the pending replies. A notification is
+// canceled instead of moved if the new owner wrote the comment or is already notified about it.
+// Moved notifications are muted again by the preferences of the new owner, sent notifications
+// are left as they are. Pending webhook tasks of moved and canceled notifications are canceled
+// on the endpoints of the previous owner, the tasks of moved ones are scheduled again on the
+// endpoints of the new owner. The event is recorded in the inbox in the same
+// transaction, a redelivered event changes nothing and returns false.
+func (rep *Repository) ChangeProductOwner(ctx context.Context, consumer string, eventID string,
+	productID int64, ownerID int64) (bool, model.OwnerChangeResult, error) {
+	processed := false
+	res := model.OwnerChangeResult{}
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		inserted, err := r.SaveProcessedEvent(ctx, &SaveProcessedEventParams{
+			Consumer: consumer,
+			EventID:  eventID,
+		})
+		if err != nil {
+			return fmt.Errorf("save processed event failed: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+		res.Canceled, err = r.CancelOwnerConflictNotifications(ctx, &CancelOwnerConflictNotificationsParams{
+			ProductID: productID,
+			OwnerID:   ownerID,
+		})
+		if err != nil {
+			return fmt.Errorf("cancel owner conflict notifications failed: %w", err)
+		}
+		res.Reassigned, err = r.ReassignOwnerNotifications(ctx, &ReassignOwnerNotificationsParams{
+			OwnerID:   ownerID,
+			ProductID: productID,
+		})
+		if err != nil {
+			return fmt.Errorf("reassign owner notifications failed: %w", err)
+		}
+		res.WebhookTasks, err = r.MoveOwnerWebhookTasks(ctx, &MoveOwnerWebhookTasksParams{
+			ProductID: productID,
+			OwnerID:   ownerID,
+			Event:     notification.EventCommentCreated,
+		})
+		if err != nil {
+			return fmt.Errorf("move owner webhook tasks failed: %w", err)
+		}
+		res.Reasons, err = r.UpdateOwnerAnswerReasons(ctx, &UpdateOwnerAnswerReasonsParams{
+			OwnerID:   ownerID,
+			ProductID: productID,
+		})
+		if err != nil {
+			return fmt.Errorf("update owner answer reasons failed: %w", err)
+		}
+		processed = true
+		return nil
+	})
+	return processed, res, err
+}
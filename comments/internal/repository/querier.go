@@ -9,11 +9,60 @@ import (
 )
 
 type Querier interface {
+	CancelOwnerConflictNotifications(ctx context.Context, arg *CancelOwnerConflictNotificationsParams) (int64, error)
+	CancelUserNotifications(ctx context.Context, userID int64) (int64, error)
+	CancelUserWebhookTasks(ctx context.Context, arg *CancelUserWebhookTasksParams) (int64, error)
+	ClaimDueDigestPreferences(ctx context.Context, arg *ClaimDueDigestPreferencesParams) ([]*NotificationPreference, error)
+	ClaimWebhookTasks(ctx context.Context, arg *ClaimWebhookTasksParams) ([]*ClaimWebhookTasksRow, error)
+	CompleteUserFanOutTasks(ctx context.Context, userID int64) (int64, error)
+	DeleteSubscription(ctx context.Context, arg *DeleteSubscriptionParams) (int64, error)
+	DeleteUserNotificationMessages(ctx context.Context, arg *DeleteUserNotificationMessagesParams) (int64, error)
+	FanOutSubscribers(ctx context.Context, arg *FanOutSubscribersParams) (*FanOutSubscribersRow, error)
+	GetComment(ctx context.Context, id int64) (*Comment, error)
+	GetCommentNotificationStatus(ctx context.Context, commentID int64) ([]*GetCommentNotificationStatusRow, error)
 	GetCommentsByProduct(ctx context.Context, productID int64) ([]*GetCommentsByProductRow, error)
-	GetUnSendNotification(ctx context.Context, limit int32) ([]*OutboxNotification, error)
-	MaskNotificationAsSend(ctx context.Context, id int64) error
+	GetNotificationChannel(ctx context.Context, ownerID int64) (*NotificationChannel, error)
+	GetNotificationPreferences(ctx context.Context, ownerID int64) (*NotificationPreference, error)
+	GetOutboxNotifications(ctx context.Context, arg *GetOutboxNotificationsParams) ([]*OutboxNotification, error)
+	GetOwnerWebhooks(ctx context.Context, ownerID int64) ([]*Webhook, error)
+	GetPendingComments(ctx context.Context, limit int32) ([]*GetPendingCommentsRow, error)
+	GetPendingFanOutTasks(ctx context.Context, limit int32) ([]*GetPendingFanOutTasksRow, error)
+	GetRecipientUnSendNotification(ctx context.Context, arg *GetRecipientUnSendNotificationParams) ([]*GetRecipientUnSendNotificationRow, error)
+	GetUnSendNotification(ctx context.Context, arg *GetUnSendNotificationParams) ([]*GetUnSendNotificationRow, error)
+	GetUserSubscriptions(ctx context.Context, userID int64) ([]*ProductSubscription, error)
+	GetWebhookDeliveries(ctx context.Context, arg *GetWebhookDeliveriesParams) ([]*GetWebhookDeliveriesRow, error)
+	GetWebhookPendingNotification(ctx context.Context, arg *GetWebhookPendingNotificationParams) ([]*OutboxNotification, error)
+	MarkEventProcessed(ctx context.Context, arg *MarkEventProcessedParams) error
+	MarkMutedNotification(ctx context.Context) (int64, error)
+	MarkMutedWebhookNotification(ctx context.Context) (int64, error)
+	MarkNotificationsAsSend(ctx context.Context, ids []int64) error
+	MarkNotificationsDelivered(ctx context.Context, arg *MarkNotificationsDeliveredParams) ([]*MarkNotificationsDeliveredRow, error)
+	MarkNotificationsRead(ctx context.Context, arg *MarkNotificationsReadParams) ([]*MarkNotificationsReadRow, error)
+	MarkWebhookNotificationDone(ctx context.Context, id int64) (int64, error)
+	MarkWebhookNotificationsDone(ctx context.Context, ids []int64) error
+	MoveOwnerWebhookTasks(ctx context.Context, arg *MoveOwnerWebhookTasksParams) (int64, error)
+	PostponeCommentValidation(ctx context.Context, arg *PostponeCommentValidationParams) error
+	PublishPendingComment(ctx context.Context, id int64) (int64, error)
+	ReassignOwnerNotifications(ctx context.Context, arg *ReassignOwnerNotificationsParams) (int64, error)
+	RejectPendingComment(ctx context.Context, arg *RejectPendingCommentParams) (int64, error)
+	RescheduleWebhookTasks(ctx context.Context, arg *RescheduleWebhookTasksParams) error
 	SaveComment(ctx context.Context, arg *SaveCommentParams) (int64, error)
+	SaveFanOutTask(ctx context.Context, arg *SaveFanOutTaskParams) error
 	SaveNotification(ctx context.Context, arg *SaveNotificationParams) error
+	SaveNotificationChannel(ctx context.Context, arg *SaveNotificationChannelParams) error
+	SaveNotificationPreferences(ctx context.Context, arg *SaveNotificationPreferencesParams) (*NotificationPreference, error)
+	SaveProcessedEvent(ctx context.Context, arg *SaveProcessedEventParams) (int64, error)
+	SaveSubscription(ctx context.Context, arg *SaveSubscriptionParams) (int64, error)
+	SaveWebhook(ctx context.Context, arg *SaveWebhookParams) (int64, error)
+	SaveWebhookDelivery(ctx context.Context, arg *SaveWebhookDeliveryParams) error
+	SaveWebhookTaskResult(ctx context.Context, arg *SaveWebhookTaskResultParams) error
+	SaveWebhookTasks(ctx context.Context, arg *SaveWebhookTasksParams) (int64, error)
+	SetProductCommentsDeleted(ctx context.Context, arg *SetProductCommentsDeletedParams) (int64, error)
+	SetUserCommentsBanned(ctx context.Context, arg *SetUserCommentsBannedParams) (int64, error)
+	StartProcessingEvent(ctx context.Context, arg *StartProcessingEventParams) (int64, error)
+	UpdateFanOutTask(ctx context.Context, arg *UpdateFanOutTaskParams) error
+	UpdateNextDigest(ctx context.Context, arg *UpdateNextDigestParams) error
+	UpdateOwnerAnswerReasons(ctx context.Context, arg *UpdateOwnerAnswerReasonsParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -1,26 +1,477 @@
 -- name: SaveComment :one
-INSERT INTO comments (user_id, product_id, tx, ts)
-VALUES ($1, $2, $3, $4)
+INSERT INTO comments (user_id, product_id, tx, ts, parent_id, status, validate_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 RETURNING id;
 
 -- name: GetCommentsByProduct :many
-SELECT id, user_id, tx, ts
+SELECT id, user_id, tx, ts, parent_id
 FROM comments
-WHERE product_id = $1;
+WHERE product_id = $1
+  AND status = 'published'
+  AND NOT product_deleted
+  AND NOT author_banned;
+
+-- name: GetComment :one
+SELECT id,
+       user_id,
+       product_id,
+       tx,
+       ts,
+       parent_id,
+       product_deleted,
+       author_banned,
+       status,
+       validation_attempts,
+       validate_at,
+       reject_reason
+FROM comments
+WHERE id = $1;
+
+-- name: GetPendingComments :many
+SELECT id, user_id, product_id, tx, ts, parent_id, validation_attempts
+FROM comments
+WHERE status = 'pending_validation'
+  AND validate_at <= now()
+ORDER BY validate_at
+    LIMIT $1;
+
+-- name: PublishPendingComment :execrows
+UPDATE comments
+SET status      = 'published',
+    validate_at = NULL
+WHERE id = $1
+  AND status = 'pending_validation';
+
+-- name: RejectPendingComment :execrows
+UPDATE comments
+SET status        = 'rejected',
+    validate_at   = NULL,
+    reject_reason = $2
+WHERE id = $1
+  AND status = 'pending_validation';
+
+-- name: PostponeCommentValidation :exec
+UPDATE comments
+SET validation_attempts = validation_attempts + 1,
+    validate_at         = $2
+WHERE id = $1
+  AND status = 'pending_validation';
+
+-- name: SetUserCommentsBanned :execrows
+UPDATE comments
+SET author_banned = $2
+WHERE user_id = $1
+  AND author_banned <> $2;
+
+-- name: CancelUserNotifications :execrows
+UPDATE outbox_notification n
+SET status         = 'canceled',
+    webhook_status = 'done'
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.user_id = $1
+  AND n.status IN ('new', 'muted');
+
+-- name: CancelUserWebhookTasks :execrows
+UPDATE webhook_tasks t
+SET status = 'canceled'
+FROM outbox_notification n
+         JOIN comments c ON c.id = n.comment_id
+WHERE n.id = t.notification_id
+  AND c.user_id = sqlc.arg(user_id)
+  AND t.event <> sqlc.arg(digest_event)
+  AND t.status = 'new';
+
+-- name: DeleteUserNotificationMessages :execrows
+DELETE
+FROM outbox o
+    USING comments c
+WHERE o.topic = sqlc.arg(topic)
+  AND o.status = 'new'
+  AND o.headers ->> 'event_type' = sqlc.arg(event_type)::text
+  AND c.id = (convert_from(o.payload, 'UTF8')::jsonb ->> 'comment_id')::bigint
+  AND c.user_id = sqlc.arg(user_id);
+
+-- name: CompleteUserFanOutTasks :execrows
+UPDATE subscription_fanout f
+SET status = 'done'
+FROM comments c
+WHERE c.id = f.comment_id
+  AND c.user_id = $1
+  AND f.status = 'new';
+
+-- name: SetProductCommentsDeleted :execrows
+UPDATE comments
+SET product_deleted = $2
+WHERE product_id = $1
+  AND product_deleted <> $2;
+
+-- name: CancelOwnerConflictNotifications :execrows
+UPDATE outbox_notification n
+SET status         = 'canceled',
+    webhook_status = 'done'
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.product_id = sqlc.arg(product_id)
+  AND n.reason = 'new_comment'
+  AND n.recipient_id <> sqlc.arg(owner_id)
+  AND n.status IN ('new', 'muted')
+  AND (c.user_id = sqlc.arg(owner_id)
+    OR EXISTS (SELECT 1
+               FROM outbox_notification o
+               WHERE o.comment_id = n.comment_id
+                 AND o.recipient_id = sqlc.arg(owner_id)));
+
+-- name: ReassignOwnerNotifications :execrows
+UPDATE outbox_notification n
+SET recipient_id = sqlc.arg(owner_id),
+    status       = 'new'
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.product_id = sqlc.arg(product_id)
+  AND n.reason = 'new_comment'
+  AND n.recipient_id <> sqlc.arg(owner_id)
+  AND n.status IN ('new', 'muted');
+
+-- name: MoveOwnerWebhookTasks :execrows
+WITH canceled AS (
+    UPDATE webhook_tasks t
+        SET status = 'canceled'
+        FROM outbox_notification n
+            JOIN comments c ON c.id = n.comment_id, webhooks w
+        WHERE n.id = t.notification_id
+            AND w.id = t.webhook_id
+            AND c.product_id = sqlc.arg(product_id)
+            AND n.reason = 'new_comment'
+            AND n.status IN ('new', 'muted', 'canceled')
+            AND w.owner_id <> sqlc.arg(owner_id)
+            AND t.event = sqlc.arg(event)
+            AND t.status = 'new'
+        RETURNING t.notification_id, t.event, t.payload, t.trace_parent, n.status <> 'canceled' AS moved)
+INSERT
+INTO webhook_tasks (webhook_id, notification_id, event, payload, trace_parent)
+SELECT DISTINCT ON (w.id, ct.notification_id) w.id,
+                                              ct.notification_id,
+                                              ct.event,
+                                              ct.payload || jsonb_build_object('recipient_id', sqlc.arg(owner_id)::bigint,
+                                                                               'owner_id', sqlc.arg(owner_id)::bigint),
+                                              ct.trace_parent
+FROM canceled ct
+         JOIN webhooks w ON w.owner_id = sqlc.arg(owner_id)
+WHERE ct.moved
+ON CONFLICT (webhook_id, notification_id, event) DO NOTHING;
+
+-- name: UpdateOwnerAnswerReasons :execrows
+UPDATE outbox_notification n
+SET reason = CASE WHEN c.user_id = sqlc.arg(owner_id) THEN 'owner_answer' ELSE 'reply' END
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.product_id = sqlc.arg(product_id)
+  AND n.status IN ('new', 'muted')
+  AND ((n.reason = 'owner_answer' AND c.user_id <> sqlc.arg(owner_id))
+    OR (n.reason = 'reply' AND c.user_id = sqlc.arg(owner_id)));
 
 
 -- name: SaveNotification :exec
-INSERT INTO outbox_notification (owner_id, comment_id, ts)
-VALUES ($1, $2, $3);
+INSERT INTO outbox_notification (recipient_id, reason, comment_id, ts, trace_parent, product_id)
+VALUES ($1, $2, $3, $4, $5, $6);
 
 -- name: GetUnSendNotification :many
-SELECT id, owner_id, comment_id, ts, status
+SELECT n.id, n.recipient_id, n.reason, n.comment_id, n.ts, n.status, n.trace_parent, n.product_id
+FROM outbox_notification n
+         LEFT JOIN notification_preferences p ON p.owner_id = n.recipient_id
+WHERE n.status = 'new'
+  AND COALESCE(p.mode, 'immediate') = 'immediate'
+  AND NOT in_quiet_hours(p.quiet_hours_start, p.quiet_hours_end, p.timezone, sqlc.arg(now)::timestamptz)
+ORDER BY n.ts
+    LIMIT sqlc.arg(max_count)
+FOR UPDATE OF n SKIP LOCKED;
+
+-- name: GetRecipientUnSendNotification :many
+SELECT id, recipient_id, reason, comment_id, ts, status, trace_parent, product_id
 FROM outbox_notification
-WHERE status = 'new'
+WHERE recipient_id = $1
+  AND status = 'new'
 ORDER BY ts
-    LIMIT $1;
+    LIMIT $2;
 
--- name: MaskNotificationAsSend :exec
+-- name: MarkMutedNotification :execrows
+UPDATE outbox_notification n
+SET status = 'muted'
+FROM notification_preferences p
+WHERE p.owner_id = n.recipient_id
+  AND n.status = 'new'
+  AND n.product_id = ANY (p.muted_products);
+
+-- name: MarkNotificationsAsSend :exec
+UPDATE outbox_notification
+SET status  = 'send',
+    sent_at = now()
+WHERE id = ANY (sqlc.arg(ids)::bigint[]);
+
+-- name: MarkNotificationsDelivered :many
 UPDATE outbox_notification
-SET status = 'send'
-WHERE id = $1;
\ No newline at end of file
+SET status       = 'delivered',
+    delivered_at = sqlc.arg(delivered_at)
+WHERE id = ANY (sqlc.arg(ids)::bigint[])
+  AND status = 'send'
+RETURNING id, ts;
+
+-- name: MarkNotificationsRead :many
+UPDATE outbox_notification
+SET status       = 'read',
+    read_at      = sqlc.arg(read_at),
+    delivered_at = COALESCE(delivered_at, sqlc.arg(read_at))
+WHERE id = ANY (sqlc.arg(ids)::bigint[])
+  AND status IN ('send', 'delivered')
+RETURNING id, ts;
+
+-- name: GetCommentNotificationStatus :many
+SELECT id, recipient_id, reason, status, ts, sent_at, delivered_at, read_at
+FROM outbox_notification
+WHERE comment_id = $1
+ORDER BY id;
+
+-- name: SaveProcessedEvent :execrows
+INSERT INTO processed_events (consumer, event_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: StartProcessingEvent :execrows
+INSERT INTO processed_events (consumer, event_id, status)
+VALUES ($1, $2, 'processing')
+ON CONFLICT (consumer, event_id) DO UPDATE
+    SET processed_at = now()
+WHERE processed_events.status = 'processing';
+
+-- name: MarkEventProcessed :exec
+UPDATE processed_events
+SET status       = 'done',
+    processed_at = now()
+WHERE consumer = $1
+  AND event_id = $2;
+
+-- name: GetNotificationChannel :one
+SELECT owner_id, channel, address, locale, secret
+FROM notification_channels
+WHERE owner_id = $1;
+
+-- name: SaveNotificationChannel :exec
+INSERT INTO notification_channels (owner_id, channel, address, locale, secret)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (owner_id) DO UPDATE SET channel = EXCLUDED.channel,
+                                     address = EXCLUDED.address,
+                                     locale  = EXCLUDED.locale,
+                                     secret  = EXCLUDED.secret;
+
+-- name: SaveWebhook :one
+INSERT INTO webhooks (owner_id, url, secret)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id, url) DO UPDATE SET secret = EXCLUDED.secret
+RETURNING id;
+
+-- name: GetOwnerWebhooks :many
+SELECT id, owner_id, url, secret, created_at
+FROM webhooks
+WHERE owner_id = $1
+ORDER BY id;
+
+-- name: GetOutboxNotifications :many
+SELECT id,
+       recipient_id,
+       comment_id,
+       ts,
+       status,
+       trace_parent,
+       webhook_status,
+       product_id,
+       reason,
+       sent_at,
+       delivered_at,
+       read_at
+FROM outbox_notification
+WHERE id >= sqlc.arg(from_id)
+  AND (sqlc.narg(to_id)::bigint IS NULL OR id <= sqlc.narg(to_id))
+  AND (sqlc.narg(status)::text IS NULL OR status = sqlc.narg(status))
+  AND (sqlc.narg(from_ts)::timestamp IS NULL OR ts >= sqlc.narg(from_ts))
+  AND (sqlc.narg(to_ts)::timestamp IS NULL OR ts < sqlc.narg(to_ts))
+ORDER BY id
+LIMIT sqlc.arg(max_count);
+
+-- name: GetWebhookPendingNotification :many
+SELECT n.id,
+       n.recipient_id,
+       n.comment_id,
+       n.ts,
+       n.status,
+       n.trace_parent,
+       n.webhook_status,
+       n.product_id,
+       n.reason,
+       n.sent_at,
+       n.delivered_at,
+       n.read_at
+FROM outbox_notification n
+         LEFT JOIN notification_preferences p ON p.owner_id = n.recipient_id
+WHERE n.webhook_status = 'new'
+  AND COALESCE(p.mode, 'immediate') = 'immediate'
+  AND NOT in_quiet_hours(p.quiet_hours_start, p.quiet_hours_end, p.timezone, sqlc.arg(now)::timestamptz)
+ORDER BY n.ts
+    LIMIT sqlc.arg(max_count);
+
+-- name: MarkMutedWebhookNotification :execrows
+UPDATE outbox_notification n
+SET webhook_status = 'done'
+FROM notification_preferences p
+WHERE p.owner_id = n.recipient_id
+  AND n.webhook_status = 'new'
+  AND n.product_id = ANY (p.muted_products);
+
+-- name: MarkWebhookNotificationDone :execrows
+UPDATE outbox_notification
+SET webhook_status = 'done'
+WHERE id = $1
+  AND webhook_status = 'new';
+
+-- name: MarkWebhookNotificationsDone :exec
+UPDATE outbox_notification
+SET webhook_status = 'done'
+WHERE id = ANY (sqlc.arg(ids)::bigint[]);
+
+-- name: SaveWebhookDelivery :exec
+INSERT INTO webhook_deliveries (webhook_id, notification_id, attempt, status_code, error, success)
+VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: SaveWebhookTasks :execrows
+INSERT INTO webhook_tasks (webhook_id, notification_id, event, payload, trace_parent)
+SELECT id, sqlc.arg(notification_id), sqlc.arg(event), sqlc.arg(payload), sqlc.narg(trace_parent)
+FROM webhooks
+WHERE owner_id = sqlc.arg(owner_id)
+ON CONFLICT (webhook_id, notification_id, event) DO NOTHING;
+
+-- name: ClaimWebhookTasks :many
+UPDATE webhook_tasks t
+SET next_attempt_at = sqlc.arg(lease_until)
+FROM webhooks w
+WHERE w.id = t.webhook_id
+  AND t.id IN (SELECT id
+               FROM webhook_tasks
+               WHERE status = 'new'
+                 AND next_attempt_at <= now()
+               ORDER BY next_attempt_at, id
+               LIMIT sqlc.arg(max_count) FOR UPDATE SKIP LOCKED)
+RETURNING t.id, t.webhook_id, w.url, w.secret, t.notification_id, t.event, t.payload, t.trace_parent, t.attempt;
+
+-- name: SaveWebhookTaskResult :exec
+UPDATE webhook_tasks
+SET attempt         = $2,
+    status          = $3,
+    next_attempt_at = $4
+WHERE id = $1
+  AND status = 'new';
+
+-- name: RescheduleWebhookTasks :exec
+UPDATE webhook_tasks
+SET next_attempt_at = sqlc.arg(next_attempt_at)
+WHERE id = ANY (sqlc.arg(ids)::bigint[])
+  AND status = 'new';
+
+-- name: GetWebhookDeliveries :many
+SELECT d.id, d.webhook_id, w.url, d.notification_id, d.attempt, d.status_code, d.error, d.success, d.ts
+FROM webhook_deliveries d
+         JOIN webhooks w ON w.id = d.webhook_id
+WHERE w.owner_id = $1
+ORDER BY d.ts DESC, d.id DESC
+    LIMIT $2;
+
+-- name: SaveNotificationPreferences :one
+INSERT INTO notification_preferences (owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end,
+                                      next_digest_at, updated_at, timezone)
+VALUES ($1, $2, $3, $4, $5, $6, now(), $7)
+ON CONFLICT (owner_id) DO UPDATE SET mode              = EXCLUDED.mode,
+                                     muted_products    = EXCLUDED.muted_products,
+                                     quiet_hours_start = EXCLUDED.quiet_hours_start,
+                                     quiet_hours_end   = EXCLUDED.quiet_hours_end,
+                                     next_digest_at    = EXCLUDED.next_digest_at,
+                                     updated_at        = EXCLUDED.updated_at,
+                                     timezone          = EXCLUDED.timezone
+RETURNING owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end, next_digest_at, updated_at, timezone;
+
+-- name: GetNotificationPreferences :one
+SELECT owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end, next_digest_at, updated_at, timezone
+FROM notification_preferences
+WHERE owner_id = $1;
+
+-- name: ClaimDueDigestPreferences :many
+UPDATE notification_preferences
+SET next_digest_at = sqlc.arg(lease_until)
+WHERE owner_id IN (SELECT owner_id
+                   FROM notification_preferences
+                   WHERE mode IN ('hourly', 'daily')
+                     AND next_digest_at <= sqlc.arg(due_at)
+                     AND NOT in_quiet_hours(quiet_hours_start, quiet_hours_end, timezone, sqlc.arg(now)::timestamptz)
+                   ORDER BY next_digest_at
+                   LIMIT sqlc.arg(max_count) FOR UPDATE SKIP LOCKED)
+RETURNING owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end, next_digest_at, updated_at, timezone;
+
+-- name: UpdateNextDigest :exec
+UPDATE notification_preferences
+SET next_digest_at = $2
+WHERE owner_id = $1;
+
+-- name: SaveSubscription :execrows
+INSERT INTO product_subscriptions (user_id, product_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteSubscription :execrows
+DELETE
+FROM product_subscriptions
+WHERE user_id = $1
+  AND product_id = $2;
+
+-- name: GetUserSubscriptions :many
+SELECT user_id, product_id, created_at
+FROM product_subscriptions
+WHERE user_id = $1
+ORDER BY created_at DESC, product_id;
+
+-- name: SaveFanOutTask :exec
+INSERT INTO subscription_fanout (comment_id, trace_parent)
+SELECT sqlc.arg(comment_id)::bigint, sqlc.narg(trace_parent)::text
+WHERE EXISTS (SELECT 1 FROM product_subscriptions WHERE product_id = sqlc.arg(product_id));
+
+-- name: GetPendingFanOutTasks :many
+SELECT f.comment_id, f.trace_parent, f.last_user_id, c.product_id, c.user_id, c.ts
+FROM subscription_fanout f
+         JOIN comments c ON c.id = f.comment_id
+WHERE f.status = 'new'
+ORDER BY f.comment_id
+    LIMIT $1;
+
+-- name: FanOutSubscribers :one
+WITH batch AS (SELECT s.user_id
+               FROM product_subscriptions s
+               WHERE s.product_id = sqlc.arg(product_id)
+                 AND s.user_id > sqlc.arg(last_user_id)
+               ORDER BY s.user_id
+                   LIMIT sqlc.arg(batch_size)),
+     inserted AS (
+         INSERT INTO outbox_notification (recipient_id, reason, comment_id, ts, trace_parent, product_id)
+             SELECT b.user_id,
+                    'subscription',
+                    sqlc.arg(comment_id)::bigint,
+                    sqlc.arg(ts)::timestamp,
+                    sqlc.narg(trace_parent)::text,
+                    sqlc.arg(product_id)
+             FROM batch b
+             WHERE b.user_id <> sqlc.arg(author_id)
+             ON CONFLICT (comment_id, recipient_id) DO NOTHING)
+SELECT COALESCE(MAX(user_id), 0)::bigint AS last_user_id, COUNT(*)::bigint AS batch_count
+FROM batch;
+
+-- name: UpdateFanOutTask :exec
+UPDATE subscription_fanout
+SET last_user_id = $2,
+    status       = $3
+WHERE comment_id = $1;
@@ -11,17 +11,406 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const cancelOwnerConflictNotifications = `-- name: CancelOwnerConflictNotifications :execrows
+UPDATE outbox_notification n
+SET status         = 'canceled',
+    webhook_status = 'done'
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.product_id = $1
+  AND n.reason = 'new_comment'
+  AND n.recipient_id <> $2
+  AND n.status IN ('new', 'muted')
+  AND (c.user_id = $2
+    OR EXISTS (SELECT 1
+               FROM outbox_notification o
+               WHERE o.comment_id = n.comment_id
+                 AND o.recipient_id = $2))
+`
+
+type CancelOwnerConflictNotificationsParams struct {
+	ProductID int64
+	OwnerID   int64
+}
+
+func (q *Queries) CancelOwnerConflictNotifications(ctx context.Context, arg *CancelOwnerConflictNotificationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelOwnerConflictNotifications, arg.ProductID, arg.OwnerID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelUserNotifications = `-- name: CancelUserNotifications :execrows
+UPDATE outbox_notification n
+SET status         = 'canceled',
+    webhook_status = 'done'
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.user_id = $1
+  AND n.status IN ('new', 'muted')
+`
+
+func (q *Queries) CancelUserNotifications(ctx context.Context, userID int64) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelUserNotifications, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const cancelUserWebhookTasks = `-- name: CancelUserWebhookTasks :execrows
+UPDATE webhook_tasks t
+SET status = 'canceled'
+FROM outbox_notification n
+         JOIN comments c ON c.id = n.comment_id
+WHERE n.id = t.notification_id
+  AND c.user_id = $1
+  AND t.event <> $2
+  AND t.status = 'new'
+`
+
+type CancelUserWebhookTasksParams struct {
+	UserID      int64
+	DigestEvent string
+}
+
+func (q *Queries) CancelUserWebhookTasks(ctx context.Context, arg *CancelUserWebhookTasksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, cancelUserWebhookTasks, arg.UserID, arg.DigestEvent)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimDueDigestPreferences = `-- name: ClaimDueDigestPreferences :many
+UPDATE notification_preferences
+SET next_digest_at = $1
+WHERE owner_id IN (SELECT owner_id
+                   FROM notification_preferences
+                   WHERE mode IN ('hourly', 'daily')
+                     AND next_digest_at <= $2
+                     AND NOT in_quiet_hours(quiet_hours_start, quiet_hours_end, timezone, $3::timestamptz)
+                   ORDER BY next_digest_at
+                   LIMIT $4 FOR UPDATE SKIP LOCKED)
+RETURNING owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end, next_digest_at, updated_at, timezone
+`
+
+type ClaimDueDigestPreferencesParams struct {
+	LeaseUntil pgtype.Timestamp
+	DueAt      pgtype.Timestamp
+	Now        pgtype.Timestamptz
+	MaxCount   int32
+}
+
+func (q *Queries) ClaimDueDigestPreferences(ctx context.Context, arg *ClaimDueDigestPreferencesParams) ([]*NotificationPreference, error) {
+	rows, err := q.db.Query(ctx, claimDueDigestPreferences,
+		arg.LeaseUntil,
+		arg.DueAt,
+		arg.Now,
+		arg.MaxCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*NotificationPreference
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(
+			&i.OwnerID,
+			&i.Mode,
+			&i.MutedProducts,
+			&i.QuietHoursStart,
+			&i.QuietHoursEnd,
+			&i.NextDigestAt,
+			&i.UpdatedAt,
+			&i.Timezone,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimWebhookTasks = `-- name: ClaimWebhookTasks :many
+UPDATE webhook_tasks t
+SET next_attempt_at = $1
+FROM webhooks w
+WHERE w.id = t.webhook_id
+  AND t.id IN (SELECT id
+               FROM webhook_tasks
+               WHERE status = 'new'
+                 AND next_attempt_at <= now()
+               ORDER BY next_attempt_at, id
+               LIMIT $2 FOR UPDATE SKIP LOCKED)
+RETURNING t.id, t.webhook_id, w.url, w.secret, t.notification_id, t.event, t.payload, t.trace_parent, t.attempt
+`
+
+type ClaimWebhookTasksParams struct {
+	LeaseUntil pgtype.Timestamp
+	MaxCount   int32
+}
+
+type ClaimWebhookTasksRow struct {
+	ID             int64
+	WebhookID      int64
+	Url            string
+	Secret         string
+	NotificationID int64
+	Event          string
+	Payload        []byte
+	TraceParent    *string
+	Attempt        int32
+}
+
+func (q *Queries) ClaimWebhookTasks(ctx context.Context, arg *ClaimWebhookTasksParams) ([]*ClaimWebhookTasksRow, error) {
+	rows, err := q.db.Query(ctx, claimWebhookTasks, arg.LeaseUntil, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ClaimWebhookTasksRow
+	for rows.Next() {
+		var i ClaimWebhookTasksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.Url,
+			&i.Secret,
+			&i.NotificationID,
+			&i.Event,
+			&i.Payload,
+			&i.TraceParent,
+			&i.Attempt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const completeUserFanOutTasks = `-- name: CompleteUserFanOutTasks :execrows
+UPDATE subscription_fanout f
+SET status = 'done'
+FROM comments c
+WHERE c.id = f.comment_id
+  AND c.user_id = $1
+  AND f.status = 'new'
+`
+
+func (q *Queries) CompleteUserFanOutTasks(ctx context.Context, userID int64) (int64, error) {
+	result, err := q.db.Exec(ctx, completeUserFanOutTasks, userID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSubscription = `-- name: DeleteSubscription :execrows
+DELETE
+FROM product_subscriptions
+WHERE user_id = $1
+  AND product_id = $2
+`
+
+type DeleteSubscriptionParams struct {
+	UserID    int64
+	ProductID int64
+}
+
+func (q *Queries) DeleteSubscription(ctx context.Context, arg *DeleteSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSubscription, arg.UserID, arg.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteUserNotificationMessages = `-- name: DeleteUserNotificationMessages :execrows
+DELETE
+FROM outbox o
+    USING comments c
+WHERE o.topic = $1
+  AND o.status = 'new'
+  AND o.headers ->> 'event_type' = $2::text
+  AND c.id = (convert_from(o.payload, 'UTF8')::jsonb ->> 'comment_id')::bigint
+  AND c.user_id = $3
+`
+
+type DeleteUserNotificationMessagesParams struct {
+	Topic     string
+	EventType string
+	UserID    int64
+}
+
+func (q *Queries) DeleteUserNotificationMessages(ctx context.Context, arg *DeleteUserNotificationMessagesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteUserNotificationMessages, arg.Topic, arg.EventType, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const fanOutSubscribers = `-- name: FanOutSubscribers :one
+WITH batch AS (SELECT s.user_id
+               FROM product_subscriptions s
+               WHERE s.product_id = $1
+                 AND s.user_id > $2
+               ORDER BY s.user_id
+                   LIMIT $3),
+     inserted AS (
+         INSERT INTO outbox_notification (recipient_id, reason, comment_id, ts, trace_parent, product_id)
+             SELECT b.user_id,
+                    'subscription',
+                    $4::bigint,
+                    $5::timestamp,
+                    $6::text,
+                    $1
+             FROM batch b
+             WHERE b.user_id <> $7
+             ON CONFLICT (comment_id, recipient_id) DO NOTHING)
+SELECT COALESCE(MAX(user_id), 0)::bigint AS last_user_id, COUNT(*)::bigint AS batch_count
+FROM batch
+`
+
+type FanOutSubscribersParams struct {
+	ProductID   int64
+	LastUserID  int64
+	BatchSize   int32
+	CommentID   int64
+	Ts          pgtype.Timestamp
+	TraceParent *string
+	AuthorID    int64
+}
+
+type FanOutSubscribersRow struct {
+	LastUserID int64
+	BatchCount int64
+}
+
+func (q *Queries) FanOutSubscribers(ctx context.Context, arg *FanOutSubscribersParams) (*FanOutSubscribersRow, error) {
+	row := q.db.QueryRow(ctx, fanOutSubscribers,
+		arg.ProductID,
+		arg.LastUserID,
+		arg.BatchSize,
+		arg.CommentID,
+		arg.Ts,
+		arg.TraceParent,
+		arg.AuthorID,
+	)
+	var i FanOutSubscribersRow
+	err := row.Scan(&i.LastUserID, &i.BatchCount)
+	return &i, err
+}
+
+const getComment = `-- name: GetComment :one
+SELECT id,
+       user_id,
+       product_id,
+       tx,
+       ts,
+       parent_id,
+       product_deleted,
+       author_banned,
+       status,
+       validation_attempts,
+       validate_at,
+       reject_reason
+FROM comments
+WHERE id = $1
+`
+
+func (q *Queries) GetComment(ctx context.Context, id int64) (*Comment, error) {
+	row := q.db.QueryRow(ctx, getComment, id)
+	var i Comment
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.ProductID,
+		&i.Tx,
+		&i.Ts,
+		&i.ParentID,
+		&i.ProductDeleted,
+		&i.AuthorBanned,
+		&i.Status,
+		&i.ValidationAttempts,
+		&i.ValidateAt,
+		&i.RejectReason,
+	)
+	return &i, err
+}
+
+const getCommentNotificationStatus = `-- name: GetCommentNotificationStatus :many
+SELECT id, recipient_id, reason, status, ts, sent_at, delivered_at, read_at
+FROM outbox_notification
+WHERE comment_id = $1
+ORDER BY id
+`
+
+type GetCommentNotificationStatusRow struct {
+	ID          int64
+	RecipientID int64
+	Reason      string
+	Status      string
+	Ts          pgtype.Timestamp
+	SentAt      pgtype.Timestamp
+	DeliveredAt pgtype.Timestamp
+	ReadAt      pgtype.Timestamp
+}
+
+func (q *Queries) GetCommentNotificationStatus(ctx context.Context, commentID int64) ([]*GetCommentNotificationStatusRow, error) {
+	rows, err := q.db.Query(ctx, getCommentNotificationStatus, commentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetCommentNotificationStatusRow
+	for rows.Next() {
+		var i GetCommentNotificationStatusRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipientID,
+			&i.Reason,
+			&i.Status,
+			&i.Ts,
+			&i.SentAt,
+			&i.DeliveredAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getCommentsByProduct = `-- name: GetCommentsByProduct :many
-SELECT id, user_id, tx, ts
+SELECT id, user_id, tx, ts, parent_id
 FROM comments
 WHERE product_id = $1
+  AND status = 'published'
+  AND NOT product_deleted
+  AND NOT author_banned
 `
 
 type GetCommentsByProductRow struct {
-	ID     int64
-	UserID int64
-	Tx     string
-	Ts     pgtype.Timestamp
+	ID       int64
+	UserID   int64
+	Tx       string
+	Ts       pgtype.Timestamp
+	ParentID *int64
 }
 
 func (q *Queries) GetCommentsByProduct(ctx context.Context, productID int64) ([]*GetCommentsByProductRow, error) {
@@ -38,6 +427,7 @@ func (q *Queries) GetCommentsByProduct(ctx context.Context, productID int64) ([]
 			&i.UserID,
 			&i.Tx,
 			&i.Ts,
+			&i.ParentID,
 		); err != nil {
 			return nil, err
 		}
@@ -49,16 +439,88 @@ func (q *Queries) GetCommentsByProduct(ctx context.Context, productID int64) ([]
 	return items, nil
 }
 
-const getUnSendNotification = `-- name: GetUnSendNotification :many
-SELECT id, owner_id, comment_id, ts, status
+const getNotificationChannel = `-- name: GetNotificationChannel :one
+SELECT owner_id, channel, address, locale, secret
+FROM notification_channels
+WHERE owner_id = $1
+`
+
+func (q *Queries) GetNotificationChannel(ctx context.Context, ownerID int64) (*NotificationChannel, error) {
+	row := q.db.QueryRow(ctx, getNotificationChannel, ownerID)
+	var i NotificationChannel
+	err := row.Scan(
+		&i.OwnerID,
+		&i.Channel,
+		&i.Address,
+		&i.Locale,
+		&i.Secret,
+	)
+	return &i, err
+}
+
+const getNotificationPreferences = `-- name: GetNotificationPreferences :one
+SELECT owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end, next_digest_at, updated_at, timezone
+FROM notification_preferences
+WHERE owner_id = $1
+`
+
+func (q *Queries) GetNotificationPreferences(ctx context.Context, ownerID int64) (*NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, getNotificationPreferences, ownerID)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.OwnerID,
+		&i.Mode,
+		&i.MutedProducts,
+		&i.QuietHoursStart,
+		&i.QuietHoursEnd,
+		&i.NextDigestAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+	)
+	return &i, err
+}
+
+const getOutboxNotifications = `-- name: GetOutboxNotifications :many
+SELECT id,
+       recipient_id,
+       comment_id,
+       ts,
+       status,
+       trace_parent,
+       webhook_status,
+       product_id,
+       reason,
+       sent_at,
+       delivered_at,
+       read_at
 FROM outbox_notification
-WHERE status = 'new'
-ORDER BY ts
-    LIMIT $1
+WHERE id >= $1
+  AND ($2::bigint IS NULL OR id <= $2)
+  AND ($3::text IS NULL OR status = $3)
+  AND ($4::timestamp IS NULL OR ts >= $4)
+  AND ($5::timestamp IS NULL OR ts < $5)
+ORDER BY id
+LIMIT $6
 `
 
-func (q *Queries) GetUnSendNotification(ctx context.Context, limit int32) ([]*OutboxNotification, error) {
-	rows, err := q.db.Query(ctx, getUnSendNotification, limit)
+type GetOutboxNotificationsParams struct {
+	FromID   int64
+	ToID     *int64
+	Status   *string
+	FromTs   pgtype.Timestamp
+	ToTs     pgtype.Timestamp
+	MaxCount int32
+}
+
+func (q *Queries) GetOutboxNotifications(ctx context.Context, arg *GetOutboxNotificationsParams) ([]*OutboxNotification, error) {
+	rows, err := q.db.Query(ctx, getOutboxNotifications,
+		arg.FromID,
+		arg.ToID,
+		arg.Status,
+		arg.FromTs,
+		arg.ToTs,
+		arg.MaxCount,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -68,10 +530,17 @@ func (q *Queries) GetUnSendNotification(ctx context.Context, limit int32) ([]*Ou
 		var i OutboxNotification
 		if err := rows.Scan(
 			&i.ID,
-			&i.OwnerID,
+			&i.RecipientID,
 			&i.CommentID,
 			&i.Ts,
 			&i.Status,
+			&i.TraceParent,
+			&i.WebhookStatus,
+			&i.ProductID,
+			&i.Reason,
+			&i.SentAt,
+			&i.DeliveredAt,
+			&i.ReadAt,
 		); err != nil {
 			return nil, err
 		}
@@ -83,54 +552,1100 @@ func (q *Queries) GetUnSendNotification(ctx context.Context, limit int32) ([]*Ou
 	return items, nil
 }
 
-const maskNotificationAsSend = `-- name: MaskNotificationAsSend :exec
-UPDATE outbox_notification
-SET status = 'send'
-WHERE id = $1
+const getOwnerWebhooks = `-- name: GetOwnerWebhooks :many
+SELECT id, owner_id, url, secret, created_at
+FROM webhooks
+WHERE owner_id = $1
+ORDER BY id
 `
 
-func (q *Queries) MaskNotificationAsSend(ctx context.Context, id int64) error {
-	_, err := q.db.Exec(ctx, maskNotificationAsSend, id)
-	return err
+func (q *Queries) GetOwnerWebhooks(ctx context.Context, ownerID int64) ([]*Webhook, error) {
+	rows, err := q.db.Query(ctx, getOwnerWebhooks, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.OwnerID,
+			&i.Url,
+			&i.Secret,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const saveComment = `-- name: SaveComment :one
-INSERT INTO comments (user_id, product_id, tx, ts)
-VALUES ($1, $2, $3, $4)
-RETURNING id
+const getPendingComments = `-- name: GetPendingComments :many
+SELECT id, user_id, product_id, tx, ts, parent_id, validation_attempts
+FROM comments
+WHERE status = 'pending_validation'
+  AND validate_at <= now()
+ORDER BY validate_at
+    LIMIT $1
 `
 
-type SaveCommentParams struct {
-	UserID    int64
-	ProductID int64
-	Tx        string
-	Ts        pgtype.Timestamp
+type GetPendingCommentsRow struct {
+	ID                 int64
+	UserID             int64
+	ProductID          int64
+	Tx                 string
+	Ts                 pgtype.Timestamp
+	ParentID           *int64
+	ValidationAttempts int32
 }
 
-func (q *Queries) SaveComment(ctx context.Context, arg *SaveCommentParams) (int64, error) {
-	row := q.db.QueryRow(ctx, saveComment,
-		arg.UserID,
-		arg.ProductID,
-		arg.Tx,
-		arg.Ts,
-	)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) GetPendingComments(ctx context.Context, limit int32) ([]*GetPendingCommentsRow, error) {
+	rows, err := q.db.Query(ctx, getPendingComments, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetPendingCommentsRow
+	for rows.Next() {
+		var i GetPendingCommentsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.ProductID,
+			&i.Tx,
+			&i.Ts,
+			&i.ParentID,
+			&i.ValidationAttempts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const saveNotification = `-- name: SaveNotification :exec
-INSERT INTO outbox_notification (owner_id, comment_id, ts)
-VALUES ($1, $2, $3)
+const getPendingFanOutTasks = `-- name: GetPendingFanOutTasks :many
+SELECT f.comment_id, f.trace_parent, f.last_user_id, c.product_id, c.user_id, c.ts
+FROM subscription_fanout f
+         JOIN comments c ON c.id = f.comment_id
+WHERE f.status = 'new'
+ORDER BY f.comment_id
+    LIMIT $1
 `
 
-type SaveNotificationParams struct {
-	OwnerID   int64
-	CommentID int64
-	Ts        pgtype.Timestamp
+type GetPendingFanOutTasksRow struct {
+	CommentID   int64
+	TraceParent *string
+	LastUserID  int64
+	ProductID   int64
+	UserID      int64
+	Ts          pgtype.Timestamp
 }
 
-func (q *Queries) SaveNotification(ctx context.Context, arg *SaveNotificationParams) error {
-	_, err := q.db.Exec(ctx, saveNotification, arg.OwnerID, arg.CommentID, arg.Ts)
-	return err
+func (q *Queries) GetPendingFanOutTasks(ctx context.Context, limit int32) ([]*GetPendingFanOutTasksRow, error) {
+	rows, err := q.db.Query(ctx, getPendingFanOutTasks, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetPendingFanOutTasksRow
+	for rows.Next() {
+		var i GetPendingFanOutTasksRow
+		if err := rows.Scan(
+			&i.CommentID,
+			&i.TraceParent,
+			&i.LastUserID,
+			&i.ProductID,
+			&i.UserID,
+			&i.Ts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecipientUnSendNotification = `-- name: GetRecipientUnSendNotification :many
+SELECT id, recipient_id, reason, comment_id, ts, status, trace_parent, product_id
+FROM outbox_notification
+WHERE recipient_id = $1
+  AND status = 'new'
+ORDER BY ts
+    LIMIT $2
+`
+
+type GetRecipientUnSendNotificationParams struct {
+	RecipientID int64
+	Limit       int32
+}
+
+type GetRecipientUnSendNotificationRow struct {
+	ID          int64
+	RecipientID int64
+	Reason      string
+	CommentID   int64
+	Ts          pgtype.Timestamp
+	Status      string
+	TraceParent *string
+	ProductID   *int64
+}
+
+func (q *Queries) GetRecipientUnSendNotification(ctx context.Context, arg *GetRecipientUnSendNotificationParams) ([]*GetRecipientUnSendNotificationRow, error) {
+	rows, err := q.db.Query(ctx, getRecipientUnSendNotification, arg.RecipientID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetRecipientUnSendNotificationRow
+	for rows.Next() {
+		var i GetRecipientUnSendNotificationRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipientID,
+			&i.Reason,
+			&i.CommentID,
+			&i.Ts,
+			&i.Status,
+			&i.TraceParent,
+			&i.ProductID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUnSendNotification = `-- name: GetUnSendNotification :many
+SELECT n.id, n.recipient_id, n.reason, n.comment_id, n.ts, n.status, n.trace_parent, n.product_id
+FROM outbox_notification n
+         LEFT JOIN notification_preferences p ON p.owner_id = n.recipient_id
+WHERE n.status = 'new'
+  AND COALESCE(p.mode, 'immediate') = 'immediate'
+  AND NOT in_quiet_hours(p.quiet_hours_start, p.quiet_hours_end, p.timezone, $1::timestamptz)
+ORDER BY n.ts
+    LIMIT $2
+FOR UPDATE OF n SKIP LOCKED
+`
+
+type GetUnSendNotificationParams struct {
+	Now      pgtype.Timestamptz
+	MaxCount int32
+}
+
+type GetUnSendNotificationRow struct {
+	ID          int64
+	RecipientID int64
+	Reason      string
+	CommentID   int64
+	Ts          pgtype.Timestamp
+	Status      string
+	TraceParent *string
+	ProductID   *int64
+}
+
+func (q *Queries) GetUnSendNotification(ctx context.Context, arg *GetUnSendNotificationParams) ([]*GetUnSendNotificationRow, error) {
+	rows, err := q.db.Query(ctx, getUnSendNotification, arg.Now, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetUnSendNotificationRow
+	for rows.Next() {
+		var i GetUnSendNotificationRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipientID,
+			&i.Reason,
+			&i.CommentID,
+			&i.Ts,
+			&i.Status,
+			&i.TraceParent,
+			&i.ProductID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getUserSubscriptions = `-- name: GetUserSubscriptions :many
+SELECT user_id, product_id, created_at
+FROM product_subscriptions
+WHERE user_id = $1
+ORDER BY created_at DESC, product_id
+`
+
+func (q *Queries) GetUserSubscriptions(ctx context.Context, userID int64) ([]*ProductSubscription, error) {
+	rows, err := q.db.Query(ctx, getUserSubscriptions, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*ProductSubscription
+	for rows.Next() {
+		var i ProductSubscription
+		if err := rows.Scan(&i.UserID, &i.ProductID, &i.CreatedAt); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookDeliveries = `-- name: GetWebhookDeliveries :many
+SELECT d.id, d.webhook_id, w.url, d.notification_id, d.attempt, d.status_code, d.error, d.success, d.ts
+FROM webhook_deliveries d
+         JOIN webhooks w ON w.id = d.webhook_id
+WHERE w.owner_id = $1
+ORDER BY d.ts DESC, d.id DESC
+    LIMIT $2
+`
+
+type GetWebhookDeliveriesParams struct {
+	OwnerID int64
+	Limit   int32
+}
+
+type GetWebhookDeliveriesRow struct {
+	ID             int64
+	WebhookID      int64
+	Url            string
+	NotificationID int64
+	Attempt        int32
+	StatusCode     int32
+	Error          string
+	Success        bool
+	Ts             pgtype.Timestamp
+}
+
+func (q *Queries) GetWebhookDeliveries(ctx context.Context, arg *GetWebhookDeliveriesParams) ([]*GetWebhookDeliveriesRow, error) {
+	rows, err := q.db.Query(ctx, getWebhookDeliveries, arg.OwnerID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetWebhookDeliveriesRow
+	for rows.Next() {
+		var i GetWebhookDeliveriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.Url,
+			&i.NotificationID,
+			&i.Attempt,
+			&i.StatusCode,
+			&i.Error,
+			&i.Success,
+			&i.Ts,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getWebhookPendingNotification = `-- name: GetWebhookPendingNotification :many
+SELECT n.id,
+       n.recipient_id,
+       n.comment_id,
+       n.ts,
+       n.status,
+       n.trace_parent,
+       n.webhook_status,
+       n.product_id,
+       n.reason,
+       n.sent_at,
+       n.delivered_at,
+       n.read_at
+FROM outbox_notification n
+         LEFT JOIN notification_preferences p ON p.owner_id = n.recipient_id
+WHERE n.webhook_status = 'new'
+  AND COALESCE(p.mode, 'immediate') = 'immediate'
+  AND NOT in_quiet_hours(p.quiet_hours_start, p.quiet_hours_end, p.timezone, $1::timestamptz)
+ORDER BY n.ts
+    LIMIT $2
+`
+
+type GetWebhookPendingNotificationParams struct {
+	Now      pgtype.Timestamptz
+	MaxCount int32
+}
+
+func (q *Queries) GetWebhookPendingNotification(ctx context.Context, arg *GetWebhookPendingNotificationParams) ([]*OutboxNotification, error) {
+	rows, err := q.db.Query(ctx, getWebhookPendingNotification, arg.Now, arg.MaxCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*OutboxNotification
+	for rows.Next() {
+		var i OutboxNotification
+		if err := rows.Scan(
+			&i.ID,
+			&i.RecipientID,
+			&i.CommentID,
+			&i.Ts,
+			&i.Status,
+			&i.TraceParent,
+			&i.WebhookStatus,
+			&i.ProductID,
+			&i.Reason,
+			&i.SentAt,
+			&i.DeliveredAt,
+			&i.ReadAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markEventProcessed = `-- name: MarkEventProcessed :exec
+UPDATE processed_events
+SET status       = 'done',
+    processed_at = now()
+WHERE consumer = $1
+  AND event_id = $2
+`
+
+type MarkEventProcessedParams struct {
+	Consumer string
+	EventID  string
+}
+
+func (q *Queries) MarkEventProcessed(ctx context.Context, arg *MarkEventProcessedParams) error {
+	_, err := q.db.Exec(ctx, markEventProcessed, arg.Consumer, arg.EventID)
+	return err
+}
+
+const markMutedNotification = `-- name: MarkMutedNotification :execrows
+UPDATE outbox_notification n
+SET status = 'muted'
+FROM notification_preferences p
+WHERE p.owner_id = n.recipient_id
+  AND n.status = 'new'
+  AND n.product_id = ANY (p.muted_products)
+`
+
+func (q *Queries) MarkMutedNotification(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, markMutedNotification)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markMutedWebhookNotification = `-- name: MarkMutedWebhookNotification :execrows
+UPDATE outbox_notification n
+SET webhook_status = 'done'
+FROM notification_preferences p
+WHERE p.owner_id = n.recipient_id
+  AND n.webhook_status = 'new'
+  AND n.product_id = ANY (p.muted_products)
+`
+
+func (q *Queries) MarkMutedWebhookNotification(ctx context.Context) (int64, error) {
+	result, err := q.db.Exec(ctx, markMutedWebhookNotification)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markNotificationsAsSend = `-- name: MarkNotificationsAsSend :exec
+UPDATE outbox_notification
+SET status  = 'send',
+    sent_at = now()
+WHERE id = ANY ($1::bigint[])
+`
+
+func (q *Queries) MarkNotificationsAsSend(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, markNotificationsAsSend, ids)
+	return err
+}
+
+const markNotificationsDelivered = `-- name: MarkNotificationsDelivered :many
+UPDATE outbox_notification
+SET status       = 'delivered',
+    delivered_at = $1
+WHERE id = ANY ($2::bigint[])
+  AND status = 'send'
+RETURNING id, ts
+`
+
+type MarkNotificationsDeliveredParams struct {
+	DeliveredAt pgtype.Timestamp
+	Ids         []int64
+}
+
+type MarkNotificationsDeliveredRow struct {
+	ID int64
+	Ts pgtype.Timestamp
+}
+
+func (q *Queries) MarkNotificationsDelivered(ctx context.Context, arg *MarkNotificationsDeliveredParams) ([]*MarkNotificationsDeliveredRow, error) {
+	rows, err := q.db.Query(ctx, markNotificationsDelivered, arg.DeliveredAt, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MarkNotificationsDeliveredRow
+	for rows.Next() {
+		var i MarkNotificationsDeliveredRow
+		if err := rows.Scan(&i.ID, &i.Ts); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markNotificationsRead = `-- name: MarkNotificationsRead :many
+UPDATE outbox_notification
+SET status       = 'read',
+    read_at      = $1,
+    delivered_at = COALESCE(delivered_at, $1)
+WHERE id = ANY ($2::bigint[])
+  AND status IN ('send', 'delivered')
+RETURNING id, ts
+`
+
+type MarkNotificationsReadParams struct {
+	ReadAt pgtype.Timestamp
+	Ids    []int64
+}
+
+type MarkNotificationsReadRow struct {
+	ID int64
+	Ts pgtype.Timestamp
+}
+
+func (q *Queries) MarkNotificationsRead(ctx context.Context, arg *MarkNotificationsReadParams) ([]*MarkNotificationsReadRow, error) {
+	rows, err := q.db.Query(ctx, markNotificationsRead, arg.ReadAt, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*MarkNotificationsReadRow
+	for rows.Next() {
+		var i MarkNotificationsReadRow
+		if err := rows.Scan(&i.ID, &i.Ts); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markWebhookNotificationDone = `-- name: MarkWebhookNotificationDone :execrows
+UPDATE outbox_notification
+SET webhook_status = 'done'
+WHERE id = $1
+  AND webhook_status = 'new'
+`
+
+func (q *Queries) MarkWebhookNotificationDone(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, markWebhookNotificationDone, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markWebhookNotificationsDone = `-- name: MarkWebhookNotificationsDone :exec
+UPDATE outbox_notification
+SET webhook_status = 'done'
+WHERE id = ANY ($1::bigint[])
+`
+
+func (q *Queries) MarkWebhookNotificationsDone(ctx context.Context, ids []int64) error {
+	_, err := q.db.Exec(ctx, markWebhookNotificationsDone, ids)
+	return err
+}
+
+const moveOwnerWebhookTasks = `-- name: MoveOwnerWebhookTasks :execrows
+WITH canceled AS (
+    UPDATE webhook_tasks t
+        SET status = 'canceled'
+        FROM outbox_notification n
+            JOIN comments c ON c.id = n.comment_id, webhooks w
+        WHERE n.id = t.notification_id
+            AND w.id = t.webhook_id
+            AND c.product_id = $1
+            AND n.reason = 'new_comment'
+            AND n.status IN ('new', 'muted', 'canceled')
+            AND w.owner_id <> $2
+            AND t.event = $3
+            AND t.status = 'new'
+        RETURNING t.notification_id, t.event, t.payload, t.trace_parent, n.status <> 'canceled' AS moved)
+INSERT
+INTO webhook_tasks (webhook_id, notification_id, event, payload, trace_parent)
+SELECT DISTINCT ON (w.id, ct.notification_id) w.id,
+                                              ct.notification_id,
+                                              ct.event,
+                                              ct.payload || jsonb_build_object('recipient_id', $2::bigint,
+                                                                               'owner_id', $2::bigint),
+                                              ct.trace_parent
+FROM canceled ct
+         JOIN webhooks w ON w.owner_id = $2
+WHERE ct.moved
+ON CONFLICT (webhook_id, notification_id, event) DO NOTHING
+`
+
+type MoveOwnerWebhookTasksParams struct {
+	ProductID int64
+	OwnerID   int64
+	Event     string
+}
+
+func (q *Queries) MoveOwnerWebhookTasks(ctx context.Context, arg *MoveOwnerWebhookTasksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, moveOwnerWebhookTasks, arg.ProductID, arg.OwnerID, arg.Event)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const postponeCommentValidation = `-- name: PostponeCommentValidation :exec
+UPDATE comments
+SET validation_attempts = validation_attempts + 1,
+    validate_at         = $2
+WHERE id = $1
+  AND status = 'pending_validation'
+`
+
+type PostponeCommentValidationParams struct {
+	ID         int64
+	ValidateAt pgtype.Timestamp
+}
+
+func (q *Queries) PostponeCommentValidation(ctx context.Context, arg *PostponeCommentValidationParams) error {
+	_, err := q.db.Exec(ctx, postponeCommentValidation, arg.ID, arg.ValidateAt)
+	return err
+}
+
+const publishPendingComment = `-- name: PublishPendingComment :execrows
+UPDATE comments
+SET status      = 'published',
+    validate_at = NULL
+WHERE id = $1
+  AND status = 'pending_validation'
+`
+
+func (q *Queries) PublishPendingComment(ctx context.Context, id int64) (int64, error) {
+	result, err := q.db.Exec(ctx, publishPendingComment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const reassignOwnerNotifications = `-- name: ReassignOwnerNotifications :execrows
+UPDATE outbox_notification n
+SET recipient_id = $1,
+    status       = 'new'
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.product_id = $2
+  AND n.reason = 'new_comment'
+  AND n.recipient_id <> $1
+  AND n.status IN ('new', 'muted')
+`
+
+type ReassignOwnerNotificationsParams struct {
+	OwnerID   int64
+	ProductID int64
+}
+
+func (q *Queries) ReassignOwnerNotifications(ctx context.Context, arg *ReassignOwnerNotificationsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reassignOwnerNotifications, arg.OwnerID, arg.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const rejectPendingComment = `-- name: RejectPendingComment :execrows
+UPDATE comments
+SET status        = 'rejected',
+    validate_at   = NULL,
+    reject_reason = $2
+WHERE id = $1
+  AND status = 'pending_validation'
+`
+
+type RejectPendingCommentParams struct {
+	ID           int64
+	RejectReason *string
+}
+
+func (q *Queries) RejectPendingComment(ctx context.Context, arg *RejectPendingCommentParams) (int64, error) {
+	result, err := q.db.Exec(ctx, rejectPendingComment, arg.ID, arg.RejectReason)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const rescheduleWebhookTasks = `-- name: RescheduleWebhookTasks :exec
+UPDATE webhook_tasks
+SET next_attempt_at = $1
+WHERE id = ANY ($2::bigint[])
+  AND status = 'new'
+`
+
+type RescheduleWebhookTasksParams struct {
+	NextAttemptAt pgtype.Timestamp
+	Ids           []int64
+}
+
+func (q *Queries) RescheduleWebhookTasks(ctx context.Context, arg *RescheduleWebhookTasksParams) error {
+	_, err := q.db.Exec(ctx, rescheduleWebhookTasks, arg.NextAttemptAt, arg.Ids)
+	return err
+}
+
+const saveComment = `-- name: SaveComment :one
+INSERT INTO comments (user_id, product_id, tx, ts, parent_id, status, validate_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id
+`
+
+type SaveCommentParams struct {
+	UserID     int64
+	ProductID  int64
+	Tx         string
+	Ts         pgtype.Timestamp
+	ParentID   *int64
+	Status     string
+	ValidateAt pgtype.Timestamp
+}
+
+func (q *Queries) SaveComment(ctx context.Context, arg *SaveCommentParams) (int64, error) {
+	row := q.db.QueryRow(ctx, saveComment,
+		arg.UserID,
+		arg.ProductID,
+		arg.Tx,
+		arg.Ts,
+		arg.ParentID,
+		arg.Status,
+		arg.ValidateAt,
+	)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const saveFanOutTask = `-- name: SaveFanOutTask :exec
+INSERT INTO subscription_fanout (comment_id, trace_parent)
+SELECT $1::bigint, $2::text
+WHERE EXISTS (SELECT 1 FROM product_subscriptions WHERE product_id = $3)
+`
+
+type SaveFanOutTaskParams struct {
+	CommentID   int64
+	TraceParent *string
+	ProductID   int64
+}
+
+func (q *Queries) SaveFanOutTask(ctx context.Context, arg *SaveFanOutTaskParams) error {
+	_, err := q.db.Exec(ctx, saveFanOutTask, arg.CommentID, arg.TraceParent, arg.ProductID)
+	return err
+}
+
+const saveNotification = `-- name: SaveNotification :exec
+INSERT INTO outbox_notification (recipient_id, reason, comment_id, ts, trace_parent, product_id)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type SaveNotificationParams struct {
+	RecipientID int64
+	Reason      string
+	CommentID   int64
+	Ts          pgtype.Timestamp
+	TraceParent *string
+	ProductID   *int64
+}
+
+func (q *Queries) SaveNotification(ctx context.Context, arg *SaveNotificationParams) error {
+	_, err := q.db.Exec(ctx, saveNotification,
+		arg.RecipientID,
+		arg.Reason,
+		arg.CommentID,
+		arg.Ts,
+		arg.TraceParent,
+		arg.ProductID,
+	)
+	return err
+}
+
+const saveNotificationChannel = `-- name: SaveNotificationChannel :exec
+INSERT INTO notification_channels (owner_id, channel, address, locale, secret)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (owner_id) DO UPDATE SET channel = EXCLUDED.channel,
+                                     address = EXCLUDED.address,
+                                     locale  = EXCLUDED.locale,
+                                     secret  = EXCLUDED.secret
+`
+
+type SaveNotificationChannelParams struct {
+	OwnerID int64
+	Channel string
+	Address string
+	Locale  string
+	Secret  string
+}
+
+func (q *Queries) SaveNotificationChannel(ctx context.Context, arg *SaveNotificationChannelParams) error {
+	_, err := q.db.Exec(ctx, saveNotificationChannel,
+		arg.OwnerID,
+		arg.Channel,
+		arg.Address,
+		arg.Locale,
+		arg.Secret,
+	)
+	return err
+}
+
+const saveNotificationPreferences = `-- name: SaveNotificationPreferences :one
+INSERT INTO notification_preferences (owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end,
+                                      next_digest_at, updated_at, timezone)
+VALUES ($1, $2, $3, $4, $5, $6, now(), $7)
+ON CONFLICT (owner_id) DO UPDATE SET mode              = EXCLUDED.mode,
+                                     muted_products    = EXCLUDED.muted_products,
+                                     quiet_hours_start = EXCLUDED.quiet_hours_start,
+                                     quiet_hours_end   = EXCLUDED.quiet_hours_end,
+                                     next_digest_at    = EXCLUDED.next_digest_at,
+                                     updated_at        = EXCLUDED.updated_at,
+                                     timezone          = EXCLUDED.timezone
+RETURNING owner_id, mode, muted_products, quiet_hours_start, quiet_hours_end, next_digest_at, updated_at, timezone
+`
+
+type SaveNotificationPreferencesParams struct {
+	OwnerID         int64
+	Mode            string
+	MutedProducts   []int64
+	QuietHoursStart *int16
+	QuietHoursEnd   *int16
+	NextDigestAt    pgtype.Timestamp
+	Timezone        string
+}
+
+func (q *Queries) SaveNotificationPreferences(ctx context.Context, arg *SaveNotificationPreferencesParams) (*NotificationPreference, error) {
+	row := q.db.QueryRow(ctx, saveNotificationPreferences,
+		arg.OwnerID,
+		arg.Mode,
+		arg.MutedProducts,
+		arg.QuietHoursStart,
+		arg.QuietHoursEnd,
+		arg.NextDigestAt,
+		arg.Timezone,
+	)
+	var i NotificationPreference
+	err := row.Scan(
+		&i.OwnerID,
+		&i.Mode,
+		&i.MutedProducts,
+		&i.QuietHoursStart,
+		&i.QuietHoursEnd,
+		&i.NextDigestAt,
+		&i.UpdatedAt,
+		&i.Timezone,
+	)
+	return &i, err
+}
+
+const saveProcessedEvent = `-- name: SaveProcessedEvent :execrows
+INSERT INTO processed_events (consumer, event_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type SaveProcessedEventParams struct {
+	Consumer string
+	EventID  string
+}
+
+func (q *Queries) SaveProcessedEvent(ctx context.Context, arg *SaveProcessedEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, saveProcessedEvent, arg.Consumer, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const saveSubscription = `-- name: SaveSubscription :execrows
+INSERT INTO product_subscriptions (user_id, product_id)
+VALUES ($1, $2)
+ON CONFLICT DO NOTHING
+`
+
+type SaveSubscriptionParams struct {
+	UserID    int64
+	ProductID int64
+}
+
+func (q *Queries) SaveSubscription(ctx context.Context, arg *SaveSubscriptionParams) (int64, error) {
+	result, err := q.db.Exec(ctx, saveSubscription, arg.UserID, arg.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const saveWebhook = `-- name: SaveWebhook :one
+INSERT INTO webhooks (owner_id, url, secret)
+VALUES ($1, $2, $3)
+ON CONFLICT (owner_id, url) DO UPDATE SET secret = EXCLUDED.secret
+RETURNING id
+`
+
+type SaveWebhookParams struct {
+	OwnerID int64
+	Url     string
+	Secret  string
+}
+
+func (q *Queries) SaveWebhook(ctx context.Context, arg *SaveWebhookParams) (int64, error) {
+	row := q.db.QueryRow(ctx, saveWebhook, arg.OwnerID, arg.Url, arg.Secret)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const saveWebhookDelivery = `-- name: SaveWebhookDelivery :exec
+INSERT INTO webhook_deliveries (webhook_id, notification_id, attempt, status_code, error, success)
+VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type SaveWebhookDeliveryParams struct {
+	WebhookID      int64
+	NotificationID int64
+	Attempt        int32
+	StatusCode     int32
+	Error          string
+	Success        bool
+}
+
+func (q *Queries) SaveWebhookDelivery(ctx context.Context, arg *SaveWebhookDeliveryParams) error {
+	_, err := q.db.Exec(ctx, saveWebhookDelivery,
+		arg.WebhookID,
+		arg.NotificationID,
+		arg.Attempt,
+		arg.StatusCode,
+		arg.Error,
+		arg.Success,
+	)
+	return err
+}
+
+const saveWebhookTaskResult = `-- name: SaveWebhookTaskResult :exec
+UPDATE webhook_tasks
+SET attempt         = $2,
+    status          = $3,
+    next_attempt_at = $4
+WHERE id = $1
+  AND status = 'new'
+`
+
+type SaveWebhookTaskResultParams struct {
+	ID            int64
+	Attempt       int32
+	Status        string
+	NextAttemptAt pgtype.Timestamp
+}
+
+func (q *Queries) SaveWebhookTaskResult(ctx context.Context, arg *SaveWebhookTaskResultParams) error {
+	_, err := q.db.Exec(ctx, saveWebhookTaskResult,
+		arg.ID,
+		arg.Attempt,
+		arg.Status,
+		arg.NextAttemptAt,
+	)
+	return err
+}
+
+const saveWebhookTasks = `-- name: SaveWebhookTasks :execrows
+INSERT INTO webhook_tasks (webhook_id, notification_id, event, payload, trace_parent)
+SELECT id, $1, $2, $3, $4
+FROM webhooks
+WHERE owner_id = $5
+ON CONFLICT (webhook_id, notification_id, event) DO NOTHING
+`
+
+type SaveWebhookTasksParams struct {
+	NotificationID int64
+	Event          string
+	Payload        []byte
+	TraceParent    *string
+	OwnerID        int64
+}
+
+func (q *Queries) SaveWebhookTasks(ctx context.Context, arg *SaveWebhookTasksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, saveWebhookTasks,
+		arg.NotificationID,
+		arg.Event,
+		arg.Payload,
+		arg.TraceParent,
+		arg.OwnerID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setProductCommentsDeleted = `-- name: SetProductCommentsDeleted :execrows
+UPDATE comments
+SET product_deleted = $2
+WHERE product_id = $1
+  AND product_deleted <> $2
+`
+
+type SetProductCommentsDeletedParams struct {
+	ProductID      int64
+	ProductDeleted bool
+}
+
+func (q *Queries) SetProductCommentsDeleted(ctx context.Context, arg *SetProductCommentsDeletedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setProductCommentsDeleted, arg.ProductID, arg.ProductDeleted)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const setUserCommentsBanned = `-- name: SetUserCommentsBanned :execrows
+UPDATE comments
+SET author_banned = $2
+WHERE user_id = $1
+  AND author_banned <> $2
+`
+
+type SetUserCommentsBannedParams struct {
+	UserID       int64
+	AuthorBanned bool
+}
+
+func (q *Queries) SetUserCommentsBanned(ctx context.Context, arg *SetUserCommentsBannedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, setUserCommentsBanned, arg.UserID, arg.AuthorBanned)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const startProcessingEvent = `-- name: StartProcessingEvent :execrows
+INSERT INTO processed_events (consumer, event_id, status)
+VALUES ($1, $2, 'processing')
+ON CONFLICT (consumer, event_id) DO UPDATE
+    SET processed_at = now()
+WHERE processed_events.status = 'processing'
+`
+
+type StartProcessingEventParams struct {
+	Consumer string
+	EventID  string
+}
+
+func (q *Queries) StartProcessingEvent(ctx context.Context, arg *StartProcessingEventParams) (int64, error) {
+	result, err := q.db.Exec(ctx, startProcessingEvent, arg.Consumer, arg.EventID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateFanOutTask = `-- name: UpdateFanOutTask :exec
+UPDATE subscription_fanout
+SET last_user_id = $2,
+    status       = $3
+WHERE comment_id = $1
+`
+
+type UpdateFanOutTaskParams struct {
+	CommentID  int64
+	LastUserID int64
+	Status     string
+}
+
+func (q *Queries) UpdateFanOutTask(ctx context.Context, arg *UpdateFanOutTaskParams) error {
+	_, err := q.db.Exec(ctx, updateFanOutTask, arg.CommentID, arg.LastUserID, arg.Status)
+	return err
+}
+
+const updateNextDigest = `-- name: UpdateNextDigest :exec
+UPDATE notification_preferences
+SET next_digest_at = $2
+WHERE owner_id = $1
+`
+
+type UpdateNextDigestParams struct {
+	OwnerID      int64
+	NextDigestAt pgtype.Timestamp
+}
+
+func (q *Queries) UpdateNextDigest(ctx context.Context, arg *UpdateNextDigestParams) error {
+	_, err := q.db.Exec(ctx, updateNextDigest, arg.OwnerID, arg.NextDigestAt)
+	return err
+}
+
+const updateOwnerAnswerReasons = `-- name: UpdateOwnerAnswerReasons :execrows
+UPDATE outbox_notification n
+SET reason = CASE WHEN c.user_id = $1 THEN 'owner_answer' ELSE 'reply' END
+FROM comments c
+WHERE c.id = n.comment_id
+  AND c.product_id = $2
+  AND n.status IN ('new', 'muted')
+  AND ((n.reason = 'owner_answer' AND c.user_id <> $1)
+    OR (n.reason = 'reply' AND c.user_id = $1))
+`
+
+type UpdateOwnerAnswerReasonsParams struct {
+	OwnerID   int64
+	ProductID int64
+}
+
+func (q *Queries) UpdateOwnerAnswerReasons(ctx context.Context, arg *UpdateOwnerAnswerReasonsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateOwnerAnswerReasons, arg.OwnerID, arg.ProductID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// MarkNotificationsDelivered moves sent notifications to the delivered state and returns the
+// creation time of every updated notification. Notifications that are already delivered or
+// read are skipped, so repeated receipts are not counted twice.
+func (rep *Repository) MarkNotificationsDelivered(ctx context.Context, notificationIDs []int64, at time.Time) ([]time.Time, error) {
+	r := New(rep.write)
+	updated, err := r.MarkNotificationsDelivered(ctx, &MarkNotificationsDeliveredParams{
+		DeliveredAt: pgtype.Timestamp{
+			Time:  at,
+			Valid: true,
+		},
+		Ids: notificationIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mark notifications delivered failed: %w", err)
+	}
+	res := make([]time.Time, len(updated))
+	for i, val := range updated {
+		res[i] = val.Ts.Time
+	}
+	return res, nil
+}
+
+// MarkNotificationsRead is MarkNotificationsDelivered for the read state, a read receipt
+// also sets the delivery time if the delivered receipt was lost.
+func (rep *Repository) MarkNotificationsRead(ctx context.Context, notificationIDs []int64, at time.Time) ([]time.Time, error) {
+	r := New(rep.write)
+	updated, err := r.MarkNotificationsRead(ctx, &MarkNotificationsReadParams{
+		ReadAt: pgtype.Timestamp{
+			Time:  at,
+			Valid: true,
+		},
+		Ids: notificationIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("mark notifications read failed: %w", err)
+	}
+	res := make([]time.Time, len(updated))
+	for i, val := range updated {
+		res[i] = val.Ts.Time
+	}
+	return res, nil
+}
+
+func (rep *Repository) GetCommentNotificationStatus(ctx context.Context, commentID int64) ([]model.NotificationStatus, error) {
+	r := New(rep.write)
+	statuses, err := r.GetCommentNotificationStatus(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("can not get notification status: %w", err)
+	}
+	res := make([]model.NotificationStatus, len(statuses))
+	for i, val := range statuses {
+		res[i] = model.NotificationStatus{
+			ID:          val.ID,
+			RecipientID: val.RecipientID,
+			Reason:      val.Reason,
+			Status:      val.Status,
+			CreatedAt:   val.Ts.Time,
+			SentAt:      val.SentAt.Time,
+			DeliveredAt: val.DeliveredAt.Time,
+			ReadAt:      val.ReadAt.Time,
+		}
+	}
+	return res, nil
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"example/comments/internal/external/notification"
 	"example/comments/internal/model"
+	"example/comments/internal/trace"
 	"fmt"
 	"time"
 
@@ -28,25 +30,64 @@ func (rep *Repository) SaveComment(ctx context.Context, comment model.Comment) (
 	err = pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
 		createdTS := time.Now()
 		r := New(tx)
-		commentID, err = r.SaveComment(ctx, &SaveCommentParams{
-			UserID:    comment.UserID,
-			ProductID: comment.ProductID,
-			Tx:        comment.Text,
-			Ts: pgtype.Timestamp{
-				Time:  createdTS,
-				Valid: true,
-			},
-		})
+		commentID, err = r.SaveComment(ctx, saveCommentParams(comment, createdTS, model.CommentPublished))
 		if err != nil {
 			return fmt.Errorf("save comment faild: %w", err)
 		}
-		err = rep.saveNotification(ctx, r, comment.ProductOwnerID, commentID, createdTS)
+		comment.ID = commentID
+		comment.Ts = createdTS
+		return rep.publishComment(ctx, tx, comment)
+	})
+	return commentID, err
+}
+
+func saveCommentParams(comment model.Comment, createdTS time.Time, status string) *SaveCommentParams {
+	params := &SaveCommentParams{
+		UserID:    comment.UserID,
+		ProductID: comment.ProductID,
+		Tx:        comment.Text,
+		Ts: pgtype.Timestamp{
+			Time:  createdTS,
+			Valid: true,
+		},
+		Status: status,
+	}
+	if comment.ParentID != 0 {
+		params.ParentID = &comment.ParentID
+	}
+	return params
+}
+
+// publishComment saves the notifications of the comment recipients, the fan-out task and the
+// comment.created event in the transaction the comment becomes visible in.
+func (rep *Repository) publishComment(ctx context.Context, tx pgx.Tx, comment model.Comment) error {
+	r := New(tx)
+	for _, recipient := range comment.Recipients {
+		err := saveRecipientNotification(ctx, r, recipient, comment.ProductID, comment.ID, comment.Ts)
 		if err != nil {
 			return fmt.Errorf("comment create ntf failed: %w", err)
 		}
-		return nil
+	}
+	// subscribers are notified later in batches, here only the fan-out task is saved
+	err := r.SaveFanOutTask(ctx, &SaveFanOutTaskParams{
+		CommentID:   comment.ID,
+		TraceParent: trace.TraceParent(ctx),
+		ProductID:   comment.ProductID,
 	})
-	return commentID, err
+	if err != nil {
+		return fmt.Errorf("save fan-out task failed: %w", err)
+	}
+	err = enqueueCommentEvent(ctx, tx, model.EventCommentCreated, model.CommentEvent{
+		CommentID: comment.ID,
+		ProductID: comment.ProductID,
+		UserID:    comment.UserID,
+		ParentID:  comment.ParentID,
+		CreatedAt: comment.Ts,
+	})
+	if err != nil {
+		return fmt.Errorf("enqueue comment event failed: %w", err)
+	}
+	return nil
 }
 
 func (rep *Repository) GetComments(ctx context.Context, productID int64) ([]model.Comment, error) {
@@ -64,41 +105,102 @@ func (rep *Repository) GetComments(ctx context.Context, productID int64) ([]mode
 			Text:      val.Tx,
 			Ts:        val.Ts.Time,
 		}
+		if val.ParentID != nil {
+			res[i].ParentID = *val.ParentID
+		}
 	}
 	return res, nil
 }
 
-func (rep *Repository) saveNotification(ctx context.Context, r *Queries, ownerID int64, commentID int64, createdTS time.Time) error {
-	err := r.SaveNotification(ctx, &SaveNotificationParams{
-		OwnerID:   ownerID,
-		CommentID: commentID,
+func (rep *Repository) GetComment(ctx context.Context, commentID int64) (model.Comment, error) {
+	r := New(rep.write)
+	comment, err := r.GetComment(ctx, commentID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Comment{}, model.ErrCommentNotFound
+	}
+	if err != nil {
+		return model.Comment{}, fmt.Errorf("can not get comment: %w", err)
+	}
+	res := model.Comment{
+		ID:        comment.ID,
+		UserID:    comment.UserID,
+		ProductID: comment.ProductID,
+		Text:      comment.Tx,
+		Ts:        comment.Ts.Time,
+		Status:    comment.Status,
+	}
+	if comment.ParentID != nil {
+		res.ParentID = *comment.ParentID
+	}
+	return res, nil
+}
+
+// saveRecipientNotification saves the delivery state of a notification, the notification is
+// enqueued to the outbox by EnqueueNotifications or CompleteDigest.
+func saveRecipientNotification(ctx context.Context, r *Queries, recipient model.Recipient, productID int64, commentID int64, createdTS time.Time) error {
+	return r.SaveNotification(ctx, &SaveNotificationParams{
+		RecipientID: recipient.UserID,
+		Reason:      recipient.Reason,
+		CommentID:   commentID,
 		Ts: pgtype.Timestamp{
 			Time:  createdTS,
 			Valid: true,
 		},
+		TraceParent: trace.TraceParent(ctx),
+		ProductID:   &productID,
 	})
-	return err
 }
 
-func (rep *Repository) GetCommentNotification(ctx context.Context) ([]notification.CommentNotification, error) {
-	r := New(rep.write)
-	ntfsEntity, err := r.GetUnSendNotification(ctx, rep.ntfCount)
-	if err != nil {
-		return []notification.CommentNotification{}, fmt.Errorf("can not get notification: %e", err)
-	}
-	ntfs := make([]notification.CommentNotification, len(ntfsEntity))
-	for i, val := range ntfsEntity {
-		ntfs[i] = notification.CommentNotification{
-			ID:        val.ID,
-			OwnerID:   val.OwnerID,
-			CommentID: val.CommentID,
-			CreatedTS: val.Ts.Time,
+// EnqueueNotifications mutes notifications about products the recipients muted and moves the
+// notifications of immediate mode recipients who are not in their quiet hours to the outbox.
+// The notifications are locked, so concurrent replicas do not enqueue one notification twice.
+func (rep *Repository) EnqueueNotifications(ctx context.Context) ([]notification.CommentNotification, error) {
+	var ntfs []notification.CommentNotification
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		if _, err := r.MarkMutedNotification(ctx); err != nil {
+			return fmt.Errorf("can not mute notifications: %w", err)
 		}
+		ntfsEntity, err := r.GetUnSendNotification(ctx, &GetUnSendNotificationParams{
+			Now:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+			MaxCount: rep.ntfCount,
+		})
+		if err != nil {
+			return fmt.Errorf("can not get notification: %w", err)
+		}
+		ntfs = make([]notification.CommentNotification, len(ntfsEntity))
+		ids := make([]int64, len(ntfsEntity))
+		for i, val := range ntfsEntity {
+			ntfs[i] = notification.CommentNotification{
+				ID:          val.ID,
+				RecipientID: val.RecipientID,
+				Reason:      val.Reason,
+				CommentID:   val.CommentID,
+				CreatedTS:   val.Ts.Time,
+			}
+			if val.TraceParent != nil {
+				ntfs[i].TraceParent = *val.TraceParent
+			}
+			if val.ProductID != nil {
+				ntfs[i].ProductID = *val.ProductID
+			}
+			err = enqueueNotification(trace.ContextWithTraceParent(ctx, ntfs[i].TraceParent), tx,
+				notification.EventCommentCreated, ntfs[i].RecipientID, ntfs[i])
+			if err != nil {
+				return fmt.Errorf("enqueue notification %d failed: %w", val.ID, err)
+			}
+			ids[i] = val.ID
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		if err = r.MarkNotificationsAsSend(ctx, ids); err != nil {
+			return fmt.Errorf("mark notifications failed: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 	return ntfs, nil
 }
-func (rep *Repository) MarkNotificationAsSend(ctx context.Context, notificationID int64) error {
-	r := New(rep.write)
-	err := r.MaskNotificationAsSend(ctx, notificationID)
-	return err
-}
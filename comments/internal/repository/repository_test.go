@@ -2,7 +2,11 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"example/comments/internal/external/notification"
 	"example/comments/internal/model"
+	"example/comments/internal/outbox"
+	"fmt"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/pressly/goose/v3"
@@ -63,7 +67,7 @@ func (s *RepositoryIntegrationTestSuite) SetupSuite() {
 		log.Fatalf("Failed to apply migrations: %v", err)
 	}
 
-	s.repository = NewRepository(s.rwPool)
+	s.repository = NewRepository(s.rwPool, 100)
 }
 
 func (s *RepositoryIntegrationTestSuite) TestSaveAndGetCommentSuccess() {
@@ -73,6 +77,7 @@ func (s *RepositoryIntegrationTestSuite) TestSaveAndGetCommentSuccess() {
 		ProductID:      123,
 		ProductOwnerID: 789,
 		Text:           "Отличный товар",
+		Recipients:     []model.Recipient{{UserID: 789, Reason: model.ReasonNewComment}},
 	}
 	comID, err := s.repository.SaveComment(ctx, com)
 	s.Suite.Require().NoError(err, "Can not save comment")
@@ -83,16 +88,33 @@ func (s *RepositoryIntegrationTestSuite) TestSaveAndGetCommentSuccess() {
 	s.Suite.Require().Equal(com.UserID, comments[0].UserID, "UserID mismatch")
 	s.Suite.Require().Equal(com.ProductID, comments[0].ProductID, "ProductID mismatch")
 	s.Suite.Require().Equal(com.Text, comments[0].Text, "Text mismatch")
-	ntfs, err := s.repository.GetCommentNotification(ctx)
-	s.Suite.Require().NoError(err, "Can not get notifications")
-	s.Suite.Require().Equal(1, len(ntfs), "Len Notifications mismatch(1)")
-	s.Suite.Require().Equal(comID, ntfs[0].CommentID, "Notification Comment ID mismatch")
-	s.Suite.Require().Equal(int64(789), ntfs[0].OwnerID, "Notification OwnerID mismatch")
-	err = s.repository.MarkNotificationAsSend(ctx, ntfs[0].ID)
-	s.Suite.Require().NoError(err, "Can not mark notification as send")
-	ntfs, err = s.repository.GetCommentNotification(ctx)
-	s.Suite.Require().NoError(err, "Can not get notifications after sent")
-	s.Suite.Require().Equal(0, len(ntfs), "Len notifications mismatch(0)")
+	ntfs, err := s.repository.EnqueueNotifications(ctx)
+	s.Suite.Require().NoError(err, "Can not enqueue notifications")
+	var ntf *notification.CommentNotification
+	for i, val := range ntfs {
+		if val.CommentID == comID {
+			ntf = &ntfs[i]
+		}
+	}
+	s.Suite.Require().NotNil(ntf, "Notification not enqueued")
+	s.Suite.Require().Equal(int64(789), ntf.RecipientID, "Notification RecipientID mismatch")
+	s.Suite.Require().Equal(model.ReasonNewComment, ntf.Reason, "Notification Reason mismatch")
+	msgs, err := s.repository.ClaimPendingMessages(ctx, 1000, time.Now())
+	s.Suite.Require().NoError(err, "Can not get outbox messages")
+	var msg *outbox.Message
+	for i, val := range msgs {
+		if val.Topic == notification.TopicNotifications && val.Key == "789" {
+			msg = &msgs[i]
+		}
+	}
+	s.Suite.Require().NotNil(msg, "Notification message not enqueued")
+	s.Suite.Require().Equal(notification.EventCommentCreated, msg.Headers[outbox.EventTypeHeader], "Event type mismatch")
+	s.Suite.Require().Contains(string(msg.Payload), fmt.Sprintf(`"comment_id":%d`, comID), "Payload mismatch")
+	ntfs, err = s.repository.EnqueueNotifications(ctx)
+	s.Suite.Require().NoError(err, "Can not enqueue notifications after sent")
+	for _, val := range ntfs {
+		s.Suite.Require().NotEqual(comID, val.CommentID, "Notification enqueued twice")
+	}
 }
 
 func (s *RepositoryIntegrationTestSuite) TestSaveCommentFailedIDNotPositive() {
@@ -102,14 +124,15 @@ func (s *RepositoryIntegrationTestSuite) TestSaveCommentFailedIDNotPositive() {
 		ProductID:      123,
 		ProductOwnerID: 789,
 		Text:           "Отличный товар",
+		Recipients:     []model.Recipient{{UserID: 789, Reason: model.ReasonNewComment}},
 	}
 	_, err := s.repository.SaveComment(ctx, com)
 	s.Suite.Require().Error(err, "Saved zero userID")
 	com.UserID = 456
-	com.ProductOwnerID = 0
+	com.Recipients = []model.Recipient{{UserID: 0, Reason: model.ReasonNewComment}}
 	_, err = s.repository.SaveComment(ctx, com)
-	s.Suite.Require().Error(err, "Saved zero ProductOwnerID")
-	com.ProductOwnerID = 789
+	s.Suite.Require().Error(err, "Saved zero RecipientID")
+	com.Recipients = []model.Recipient{{UserID: 789, Reason: model.ReasonNewComment}}
 	com.ProductID = 0
 	_, err = s.repository.SaveComment(ctx, com)
 	s.Suite.Require().Error(err, "Saved zero ProductID")
@@ -140,3 +163,683 @@ func (s *RepositoryIntegrationTestSuite) TestSaveCommentFailedWrongTextLen() {
 	_, err = s.repository.SaveComment(ctx, com)
 	s.Suite.Require().Error(err, "len text > 256")
 }
+
+func (s *RepositoryIntegrationTestSuite) TestWebhookDeliveriesLog() {
+	ctx := context.Background()
+	webhookID, err := s.repository.SaveWebhook(ctx, model.Webhook{
+		OwnerID: 901,
+		URL:     "http://seller.local/hook",
+		Secret:  "0123456789abcdef",
+	})
+	s.Suite.Require().NoError(err, "Can not save webhook")
+	sameID, err := s.repository.SaveWebhook(ctx, model.Webhook{
+		OwnerID: 901,
+		URL:     "http://seller.local/hook",
+		Secret:  "fedcba9876543210",
+	})
+	s.Suite.Require().NoError(err, "Can not rotate webhook secret")
+	s.Suite.Require().Equal(webhookID, sameID, "Webhook ID changed on secret rotation")
+	webhooks, err := s.repository.GetOwnerWebhooks(ctx, 901)
+	s.Suite.Require().NoError(err, "Can not get webhooks")
+	s.Suite.Require().Equal(1, len(webhooks), "Len webhooks mismatch")
+	s.Suite.Require().Equal("fedcba9876543210", webhooks[0].Secret, "Secret mismatch")
+
+	for attempt := int32(1); attempt <= 2; attempt++ {
+		err = s.repository.SaveWebhookDelivery(ctx, model.WebhookDelivery{
+			WebhookID:      webhookID,
+			NotificationID: 1,
+			Attempt:        attempt,
+			StatusCode:     200,
+			Success:        attempt == 2,
+		})
+		s.Suite.Require().NoError(err, "Can not save webhook delivery")
+	}
+	deliveries, err := s.repository.GetWebhookDeliveries(ctx, 901, 10)
+	s.Suite.Require().NoError(err, "Can not get webhook deliveries")
+	s.Suite.Require().Equal(2, len(deliveries), "Len deliveries mismatch")
+	s.Suite.Require().Equal(int32(2), deliveries[0].Attempt, "Deliveries order mismatch")
+	s.Suite.Require().Equal("http://seller.local/hook", deliveries[0].URL, "URL mismatch")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestWebhookTasks() {
+	ctx := context.Background()
+	webhookID, err := s.repository.SaveWebhook(ctx, model.Webhook{
+		OwnerID: 902,
+		URL:     "http://seller.local/tasks",
+		Secret:  "0123456789abcdef",
+	})
+	s.Suite.Require().NoError(err, "Can not save webhook")
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         470,
+		ProductID:      140,
+		ProductOwnerID: 903,
+		Text:           "Ответ на отзыв",
+		Recipients:     []model.Recipient{{UserID: 902, Reason: model.ReasonReply}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(1, len(statuses), "Len statuses mismatch")
+	ntf := notification.CommentNotification{ID: statuses[0].ID, RecipientID: 902, Reason: model.ReasonReply}
+	// a second replica scheduling the same notification must not duplicate the task
+	for range 2 {
+		err = s.repository.ScheduleWebhookTasks(ctx, ntf, notification.EventCommentReply, []byte(`{"id":5001}`))
+		s.Suite.Require().NoError(err, "Can not schedule webhook tasks")
+	}
+
+	tasks, err := s.repository.ClaimWebhookTasks(ctx, time.Now().Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim webhook tasks")
+	s.Suite.Require().Equal(1, len(tasks), "Len tasks mismatch")
+	s.Suite.Require().Equal(webhookID, tasks[0].Webhook.ID, "Webhook mismatch")
+	s.Suite.Require().Equal(notification.EventCommentReply, tasks[0].Event, "Event mismatch")
+	leased, err := s.repository.ClaimWebhookTasks(ctx, time.Now().Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim webhook tasks")
+	s.Suite.Require().Equal(0, len(leased), "Leased task is claimed again")
+
+	task := tasks[0]
+	task.Attempt = 1
+	task.NextAttemptTS = time.Now().Add(-time.Second)
+	s.Suite.Require().NoError(s.repository.SaveWebhookTaskResult(ctx, task), "Can not save webhook task")
+	retried, err := s.repository.ClaimWebhookTasks(ctx, time.Now().Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim webhook tasks")
+	s.Suite.Require().Equal(1, len(retried), "Due task is not claimed")
+	s.Suite.Require().Equal(int32(1), retried[0].Attempt, "Attempt mismatch")
+
+	task.Status = model.WebhookTaskDone
+	s.Suite.Require().NoError(s.repository.SaveWebhookTaskResult(ctx, task), "Can not save webhook task")
+	s.Suite.Require().NoError(s.repository.RescheduleWebhookTasks(ctx, []int64{task.ID}, time.Now().Add(-time.Second)))
+	done, err := s.repository.ClaimWebhookTasks(ctx, time.Now().Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim webhook tasks")
+	s.Suite.Require().Equal(0, len(done), "Done task is claimed")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestDigestPreferences() {
+	ctx := context.Background()
+	now := time.Now().UTC()
+	_, err := s.repository.SaveNotificationPreferences(ctx, model.NotificationPreferences{
+		OwnerID:         790,
+		Mode:            model.ModeDailyDigest,
+		MutedProductIDs: []int64{224},
+		QuietHours:      &model.QuietHours{Start: 14, End: 16},
+		Timezone:        "Europe/Moscow",
+		NextDigestAt:    model.NextDigestAt(model.ModeDailyDigest, now, time.UTC),
+	})
+	s.Suite.Require().NoError(err, "Can not save preferences")
+	for _, productID := range []int64{223, 224} {
+		_, err = s.repository.SaveComment(ctx, model.Comment{
+			UserID:         456,
+			ProductID:      productID,
+			ProductOwnerID: 790,
+			Text:           "Хороший товар",
+			Recipients:     []model.Recipient{{UserID: 790, Reason: model.ReasonNewComment}},
+		})
+		s.Suite.Require().NoError(err, "Can not save comment")
+	}
+	ntfs, err := s.repository.EnqueueNotifications(ctx)
+	s.Suite.Require().NoError(err, "Can not enqueue notifications")
+	for _, ntf := range ntfs {
+		s.Suite.Require().NotEqual(int64(790), ntf.RecipientID, "Digest owner notified immediately")
+	}
+	webhookNtfs, err := s.repository.GetWebhookPendingNotification(ctx)
+	s.Suite.Require().NoError(err, "Can not get webhook notifications")
+	for _, ntf := range webhookNtfs {
+		s.Suite.Require().NotEqual(int64(790), ntf.RecipientID, "Digest owner webhook sent immediately")
+	}
+	ntfs, err = s.repository.GetRecipientNotification(ctx, 790)
+	s.Suite.Require().NoError(err, "Can not get owner notifications")
+	s.Suite.Require().Equal(1, len(ntfs), "Len owner notifications mismatch")
+	s.Suite.Require().Equal(int64(223), ntfs[0].ProductID, "Muted product notified")
+
+	due, err := s.repository.ClaimDueDigests(ctx, now, now.Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim due digests")
+	s.Suite.Require().Equal(0, len(due), "Digest due before period end")
+	// 12:00 UTC is 15:00 in Moscow, within the quiet hours
+	digestAt := time.Date(now.Year(), now.Month(), now.Day(), 12, 0, 0, 0, time.UTC).AddDate(0, 0, 2)
+	due, err = s.repository.ClaimDueDigests(ctx, digestAt, digestAt.Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim due digests")
+	s.Suite.Require().Equal(0, len(due), "Digest claimed in quiet hours")
+	digestAt = digestAt.Add(2 * time.Hour)
+	due, err = s.repository.ClaimDueDigests(ctx, digestAt, digestAt.Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim due digests")
+	s.Suite.Require().Equal(1, len(due), "Len due digests mismatch")
+	s.Suite.Require().Equal("Europe/Moscow", due[0].Timezone, "Timezone mismatch")
+	claimed, err := s.repository.ClaimDueDigests(ctx, digestAt, digestAt.Add(time.Minute))
+	s.Suite.Require().NoError(err, "Can not claim due digests")
+	s.Suite.Require().Equal(0, len(claimed), "Claimed digest is claimed again")
+	digest := notification.NewDigestNotification(790, model.ModeDailyDigest, ntfs)
+	err = s.repository.CompleteDigest(ctx, 790, &digest, digestAt.Add(48*time.Hour))
+	s.Suite.Require().NoError(err, "Can not complete digest")
+	msgs, err := s.repository.ClaimPendingMessages(ctx, 1000, time.Now())
+	s.Suite.Require().NoError(err, "Can not get outbox messages")
+	digests := 0
+	for _, val := range msgs {
+		if val.Topic == notification.TopicNotifications && val.Headers[outbox.EventTypeHeader] == notification.EventCommentDigest {
+			s.Suite.Require().Equal("790", val.Key, "Digest key mismatch")
+			digests++
+		}
+	}
+	s.Suite.Require().Equal(1, digests, "Len digest messages mismatch")
+	ntfs, err = s.repository.GetRecipientNotification(ctx, 790)
+	s.Suite.Require().NoError(err, "Can not get owner notifications after digest")
+	s.Suite.Require().Equal(0, len(ntfs), "Len owner notifications mismatch(0)")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestSaveReply() {
+	ctx := context.Background()
+	parentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         457,
+		ProductID:      125,
+		ProductOwnerID: 791,
+		Text:           "Есть ли другие цвета?",
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	replyID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         791,
+		ProductID:      125,
+		ProductOwnerID: 791,
+		ParentID:       parentID,
+		Text:           "Да, синий и красный",
+		Recipients:     []model.Recipient{{UserID: 457, Reason: model.ReasonOwnerAnswer}},
+	})
+	s.Suite.Require().NoError(err, "Can not save reply")
+	reply, err := s.repository.GetComment(ctx, replyID)
+	s.Suite.Require().NoError(err, "Can not get reply")
+	s.Suite.Require().Equal(parentID, reply.ParentID, "ParentID mismatch")
+	_, err = s.repository.GetComment(ctx, replyID+1000)
+	s.Suite.Require().ErrorIs(err, model.ErrCommentNotFound, "Error mismatch")
+	ntfs, err := s.repository.GetRecipientNotification(ctx, 457)
+	s.Suite.Require().NoError(err, "Can not get recipient notifications")
+	s.Suite.Require().Equal(1, len(ntfs), "Len notifications mismatch")
+	s.Suite.Require().Equal(model.ReasonOwnerAnswer, ntfs[0].Reason, "Reason mismatch")
+	s.Suite.Require().Equal(replyID, ntfs[0].CommentID, "Comment ID mismatch")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestSubscriptionFanOut() {
+	ctx := context.Background()
+	for userID := int64(501); userID <= 505; userID++ {
+		created, err := s.repository.SaveSubscription(ctx, userID, 126)
+		s.Suite.Require().NoError(err, "Can not subscribe")
+		s.Suite.Require().True(created, "Subscription not created")
+	}
+	created, err := s.repository.SaveSubscription(ctx, 501, 126)
+	s.Suite.Require().NoError(err, "Can not subscribe twice")
+	s.Suite.Require().False(created, "Subscription duplicated")
+	subscriptions, err := s.repository.GetUserSubscriptions(ctx, 501)
+	s.Suite.Require().NoError(err, "Can not get subscriptions")
+	s.Suite.Require().Equal(1, len(subscriptions), "Len subscriptions mismatch")
+
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         503,
+		ProductID:      126,
+		ProductOwnerID: 792,
+		Text:           "Пришел быстро",
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	tasks, err := s.repository.GetPendingFanOutTasks(ctx)
+	s.Suite.Require().NoError(err, "Can not get fan-out tasks")
+	s.Suite.Require().Equal(1, len(tasks), "Len fan-out tasks mismatch")
+	s.Suite.Require().Equal(commentID, tasks[0].CommentID, "Fan-out comment mismatch")
+
+	task, done := tasks[0], false
+	for batches := 0; !done; batches++ {
+		s.Suite.Require().Less(batches, 3, "Fan-out is not finished")
+		task.LastUserID, done, err = s.repository.FanOutBatch(ctx, task, 2)
+		s.Suite.Require().NoError(err, "Fan-out batch failed")
+	}
+	for userID := int64(501); userID <= 505; userID++ {
+		ntfs, err := s.repository.GetRecipientNotification(ctx, userID)
+		s.Suite.Require().NoError(err, "Can not get subscriber notifications")
+		if userID == 503 {
+			s.Suite.Require().Equal(0, len(ntfs), "Author notified")
+			continue
+		}
+		s.Suite.Require().Equal(1, len(ntfs), "Len subscriber notifications mismatch")
+		s.Suite.Require().Equal(model.ReasonSubscription, ntfs[0].Reason, "Reason mismatch")
+	}
+	tasks, err = s.repository.GetPendingFanOutTasks(ctx)
+	s.Suite.Require().NoError(err, "Can not get fan-out tasks")
+	s.Suite.Require().Equal(0, len(tasks), "Fan-out task is not done")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestDeliveryReceipts() {
+	ctx := context.Background()
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         458,
+		ProductID:      127,
+		ProductOwnerID: 793,
+		Text:           "Не подошел размер",
+		Recipients:     []model.Recipient{{UserID: 793, Reason: model.ReasonNewComment}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(1, len(statuses), "Len statuses mismatch")
+	ntfID := statuses[0].ID
+
+	created, err := s.repository.MarkNotificationsDelivered(ctx, []int64{ntfID}, time.Now())
+	s.Suite.Require().NoError(err, "Can not mark new notification delivered")
+	s.Suite.Require().Equal(0, len(created), "Not sent notification delivered")
+	_, err = s.repository.EnqueueNotifications(ctx)
+	s.Suite.Require().NoError(err, "Can not enqueue notifications")
+	created, err = s.repository.MarkNotificationsRead(ctx, []int64{ntfID}, time.Now())
+	s.Suite.Require().NoError(err, "Can not mark notification read")
+	s.Suite.Require().Equal(1, len(created), "Len read notifications mismatch")
+	created, err = s.repository.MarkNotificationsDelivered(ctx, []int64{ntfID}, time.Now())
+	s.Suite.Require().NoError(err, "Can not mark read notification delivered")
+	s.Suite.Require().Equal(0, len(created), "Read notification moved back")
+
+	statuses, err = s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(model.NotificationRead, statuses[0].Status, "Status mismatch")
+	s.Suite.Require().False(statuses[0].SentAt.IsZero(), "Sent time is empty")
+	s.Suite.Require().False(statuses[0].DeliveredAt.IsZero(), "Delivered time is empty")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestCommentEventOutbox() {
+	ctx := context.Background()
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         459,
+		ProductID:      128,
+		ProductOwnerID: 794,
+		Text:           "Хорошее качество",
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	msgs, err := s.repository.ClaimPendingMessages(ctx, 1000, time.Now())
+	s.Suite.Require().NoError(err, "Can not get outbox messages")
+	var msg *outbox.Message
+	for i, val := range msgs {
+		if val.Topic != model.TopicCommentEvents {
+			continue
+		}
+		var event model.CommentEvent
+		s.Suite.Require().NoError(json.Unmarshal(val.Payload, &event), "Can not unmarshal comment event")
+		if event.CommentID == commentID {
+			msg = &msgs[i]
+		}
+	}
+	s.Suite.Require().NotNil(msg, "Comment event not enqueued")
+	s.Suite.Require().Equal(model.TopicCommentEvents, msg.Topic, "Topic mismatch")
+	s.Suite.Require().Equal("128", msg.Key, "Key mismatch")
+	s.Suite.Require().Equal(model.EventCommentCreated, msg.Headers[outbox.EventTypeHeader], "Event type mismatch")
+	err = s.repository.MarkMessagesSent(ctx, []int64{msg.ID})
+	s.Suite.Require().NoError(err, "Can not mark outbox message as sent")
+	msgs, err = s.repository.ClaimPendingMessages(ctx, 1000, time.Now())
+	s.Suite.Require().NoError(err, "Can not get outbox messages after sent")
+	for _, val := range msgs {
+		s.Suite.Require().NotEqual(msg.ID, val.ID, "Sent message is pending")
+	}
+}
+
+func (s *RepositoryIntegrationTestSuite) TestStartEvent() {
+	ctx := context.Background()
+	started, err := s.repository.StartEvent(ctx, "notifier", "start-event-1")
+	s.Suite.Require().NoError(err, "Can not start event")
+	s.Suite.Require().True(started, "New event is not started")
+	started, err = s.repository.StartEvent(ctx, "notifier", "start-event-1")
+	s.Suite.Require().NoError(err, "Can not start event again")
+	s.Suite.Require().True(started, "Unfinished event is not started again")
+	s.Suite.Require().NoError(s.repository.CompleteEvent(ctx, "notifier", "start-event-1"), "Can not complete event")
+	started, err = s.repository.StartEvent(ctx, "notifier", "start-event-1")
+	s.Suite.Require().NoError(err, "Can not start processed event")
+	s.Suite.Require().False(started, "Processed event is started again")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestSaveNotificationChannel() {
+	ctx := context.Background()
+	channel := model.NotificationChannel{OwnerID: 910, Kind: model.ChannelEmail, Address: "owner@example.com", Locale: "en"}
+	s.Suite.Require().NoError(s.repository.SaveNotificationChannel(ctx, channel), "Can not save channel")
+	channel = model.NotificationChannel{OwnerID: 910, Kind: model.ChannelWebhook, Address: "https://example.com/hook",
+		Locale: "ru", Secret: "0123456789abcdef"}
+	s.Suite.Require().NoError(s.repository.SaveNotificationChannel(ctx, channel), "Can not replace channel")
+	saved, err := s.repository.GetNotificationChannel(ctx, 910)
+	s.Suite.Require().NoError(err, "Can not get channel")
+	s.Suite.Require().Equal(channel, saved, "Channel mismatch")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestReplayNotifications() {
+	ctx := context.Background()
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         460,
+		ProductID:      129,
+		ProductOwnerID: 795,
+		Text:           "Странный запах",
+		Recipients:     []model.Recipient{{UserID: 795, Reason: model.ReasonNewComment}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(1, len(statuses), "Len statuses mismatch")
+	filter := model.OutboxFilter{FromID: statuses[0].ID, ToID: statuses[0].ID, Status: "new", Limit: 10}
+
+	records, err := s.repository.GetOutboxRecords(ctx, filter)
+	s.Suite.Require().NoError(err, "Can not get outbox records")
+	s.Suite.Require().Equal(1, len(records), "Len records mismatch")
+	s.Suite.Require().Equal(commentID, records[0].CommentID, "Record comment mismatch")
+	s.Suite.Require().Contains(string(records[0].Payload), `"recipient_id":795`, "Payload mismatch")
+
+	countReplayed := func() int {
+		msgs, err := s.repository.ClaimPendingMessages(ctx, 1000, time.Now())
+		s.Suite.Require().NoError(err, "Can not get outbox messages")
+		count := 0
+		for _, val := range msgs {
+			if val.Topic == notification.TopicNotifications && val.Key == "795" {
+				count++
+			}
+		}
+		return count
+	}
+	before := countReplayed()
+	records, err = s.repository.ReplayNotifications(ctx, filter, "", true)
+	s.Suite.Require().NoError(err, "Can not dry-run replay")
+	s.Suite.Require().Equal(1, len(records), "Len dry-run records mismatch")
+	s.Suite.Require().Equal(before, countReplayed(), "Dry-run enqueued messages")
+	_, err = s.repository.ReplayNotifications(ctx, filter, "", false)
+	s.Suite.Require().NoError(err, "Can not replay")
+	s.Suite.Require().Equal(before+1, countReplayed(), "Len replayed messages mismatch")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestReplayOutboxMessages() {
+	ctx := context.Background()
+	_, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         471,
+		ProductID:      141,
+		ProductOwnerID: 797,
+		Text:           "Пришел с опозданием",
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	countEvents := func() []model.OutboxMessage {
+		msgs, err := s.repository.GetOutboxMessages(ctx, model.OutboxMessageFilter{
+			Topic: model.TopicCommentEvents,
+			Limit: model.MaxOutboxRecords,
+		})
+		s.Suite.Require().NoError(err, "Can not get outbox messages")
+		var res []model.OutboxMessage
+		for _, val := range msgs {
+			s.Suite.Require().Equal(model.TopicCommentEvents, val.Topic, "Topic filter mismatch")
+			if val.Key == "141" {
+				res = append(res, val)
+			}
+		}
+		return res
+	}
+	events := countEvents()
+	s.Suite.Require().Equal(1, len(events), "Len events mismatch")
+	filter := model.OutboxMessageFilter{FromID: events[0].ID, ToID: events[0].ID, Limit: 10}
+
+	msgs, err := s.repository.ReplayOutboxMessages(ctx, filter, true)
+	s.Suite.Require().NoError(err, "Can not dry-run replay")
+	s.Suite.Require().Equal(1, len(msgs), "Len dry-run messages mismatch")
+	s.Suite.Require().Equal(1, len(countEvents()), "Dry-run enqueued messages")
+	_, err = s.repository.ReplayOutboxMessages(ctx, filter, false)
+	s.Suite.Require().NoError(err, "Can not replay")
+	replayed := countEvents()
+	s.Suite.Require().Equal(2, len(replayed), "Len replayed messages mismatch")
+	s.Suite.Require().Equal(events[0].Payload, replayed[1].Payload, "Replayed payload mismatch")
+	s.Suite.Require().Equal(model.EventCommentCreated, replayed[1].Headers[outbox.EventTypeHeader], "Replayed event type mismatch")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestProductDeletedHidesComments() {
+	ctx := context.Background()
+	_, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         461,
+		ProductID:      130,
+		ProductOwnerID: 796,
+		Text:           "Товар сняли с продажи",
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+
+	processed, changed, err := s.repository.SetProductDeleted(ctx, "test", "product-deleted-1", 130, true)
+	s.Suite.Require().NoError(err, "Can not delete product")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), changed, "Hidden comments mismatch")
+	comments, err := s.repository.GetComments(ctx, 130)
+	s.Suite.Require().NoError(err, "Can not get comments")
+	s.Suite.Require().Equal(0, len(comments), "Comments of deleted product are visible")
+
+	processed, _, err = s.repository.SetProductDeleted(ctx, "test", "product-deleted-1", 130, true)
+	s.Suite.Require().NoError(err, "Can not process duplicate")
+	s.Suite.Require().False(processed, "Duplicate event processed")
+
+	processed, changed, err = s.repository.SetProductDeleted(ctx, "test", "product-restored-1", 130, false)
+	s.Suite.Require().NoError(err, "Can not restore product")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), changed, "Restored comments mismatch")
+	comments, err = s.repository.GetComments(ctx, 130)
+	s.Suite.Require().NoError(err, "Can not get comments after restore")
+	s.Suite.Require().Equal(1, len(comments), "Comments of restored product are hidden")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestUserBannedHidesComments() {
+	ctx := context.Background()
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         462,
+		ProductID:      131,
+		ProductOwnerID: 797,
+		Text:           "Спам спам спам",
+		Recipients:     []model.Recipient{{UserID: 797, Reason: model.ReasonNewComment}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+
+	processed, res, err := s.repository.SetUserBanned(ctx, "test", "user-banned-1", 462, true)
+	s.Suite.Require().NoError(err, "Can not ban user")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), res.Comments, "Hidden comments mismatch")
+	s.Suite.Require().Equal(int64(1), res.Notifications, "Canceled notifications mismatch")
+	comments, err := s.repository.GetComments(ctx, 131)
+	s.Suite.Require().NoError(err, "Can not get comments")
+	s.Suite.Require().Equal(0, len(comments), "Comments of banned user are visible")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(model.NotificationCanceled, statuses[0].Status, "Notification status mismatch")
+
+	processed, res, err = s.repository.SetUserBanned(ctx, "test", "user-unbanned-1", 462, false)
+	s.Suite.Require().NoError(err, "Can not unban user")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), res.Comments, "Restored comments mismatch")
+	comments, err = s.repository.GetComments(ctx, 131)
+	s.Suite.Require().NoError(err, "Can not get comments after unban")
+	s.Suite.Require().Equal(1, len(comments), "Comments of unbanned user are hidden")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestUserBannedCancelsHandedOverNotifications() {
+	ctx := context.Background()
+	webhookID, err := s.repository.SaveWebhook(ctx, model.Webhook{
+		OwnerID: 904,
+		URL:     "http://seller.local/banned",
+		Secret:  "0123456789abcdef",
+	})
+	s.Suite.Require().NoError(err, "Can not save webhook")
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         472,
+		ProductID:      142,
+		ProductOwnerID: 904,
+		Text:           "Спам со ссылкой",
+		Recipients:     []model.Recipient{{UserID: 904, Reason: model.ReasonNewComment}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	ntf := notification.CommentNotification{
+		ID:          statuses[0].ID,
+		RecipientID: 904,
+		Reason:      model.ReasonNewComment,
+		CommentID:   commentID,
+		ProductID:   142,
+	}
+	err = enqueueNotification(ctx, s.rwPool, notification.EventCommentCreated, ntf.RecipientID, ntf)
+	s.Suite.Require().NoError(err, "Can not enqueue notification")
+	err = s.repository.ScheduleWebhookTasks(ctx, ntf, notification.EventCommentCreated, []byte(`{"id":5002}`))
+	s.Suite.Require().NoError(err, "Can not schedule webhook tasks")
+
+	processed, res, err := s.repository.SetUserBanned(ctx, "test", "user-banned-2", 472, true)
+	s.Suite.Require().NoError(err, "Can not ban user")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), res.WebhookTasks, "Canceled webhook tasks mismatch")
+	s.Suite.Require().Equal(int64(1), res.Messages, "Deleted messages mismatch")
+	var taskStatus string
+	err = s.rwPool.QueryRow(ctx, "SELECT status FROM webhook_tasks WHERE webhook_id = $1", webhookID).Scan(&taskStatus)
+	s.Suite.Require().NoError(err, "Can not get webhook task")
+	s.Suite.Require().Equal(model.WebhookTaskCanceled, taskStatus, "Webhook task status mismatch")
+	msgs, err := s.repository.GetOutboxMessages(ctx, model.OutboxMessageFilter{
+		Status: "new",
+		Topic:  notification.TopicNotifications,
+		Limit:  model.MaxOutboxRecords,
+	})
+	s.Suite.Require().NoError(err, "Can not get outbox messages")
+	for _, val := range msgs {
+		s.Suite.Require().NotEqual("904", val.Key, "Message about banned user is left")
+	}
+}
+
+func (s *RepositoryIntegrationTestSuite) TestProductOwnerChangedMovesNotifications() {
+	ctx := context.Background()
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         463,
+		ProductID:      132,
+		ProductOwnerID: 798,
+		Text:           "Когда будет поставка?",
+		Recipients:     []model.Recipient{{UserID: 798, Reason: model.ReasonNewComment}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	answerID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         798,
+		ProductID:      132,
+		ParentID:       commentID,
+		ProductOwnerID: 798,
+		Text:           "На следующей неделе",
+		Recipients:     []model.Recipient{{UserID: 463, Reason: model.ReasonOwnerAnswer}},
+	})
+	s.Suite.Require().NoError(err, "Can not save answer")
+
+	processed, res, err := s.repository.ChangeProductOwner(ctx, "test", "owner-changed-1", 132, 799)
+	s.Suite.Require().NoError(err, "Can not change owner")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), res.Reassigned, "Reassigned notifications mismatch")
+	s.Suite.Require().Equal(int64(0), res.Canceled, "Canceled notifications mismatch")
+	s.Suite.Require().Equal(int64(1), res.Reasons, "Updated reasons mismatch")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(int64(799), statuses[0].RecipientID, "Recipient mismatch")
+	statuses, err = s.repository.GetCommentNotificationStatus(ctx, answerID)
+	s.Suite.Require().NoError(err, "Can not get answer notification status")
+	s.Suite.Require().Equal(model.ReasonReply, statuses[0].Reason, "Reason mismatch")
+
+	processed, _, err = s.repository.ChangeProductOwner(ctx, "test", "owner-changed-1", 132, 799)
+	s.Suite.Require().NoError(err, "Can not process duplicate")
+	s.Suite.Require().False(processed, "Duplicate event processed")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestProductOwnerChangedMovesWebhookTasks() {
+	ctx := context.Background()
+	oldWebhookID, err := s.repository.SaveWebhook(ctx, model.Webhook{
+		OwnerID: 905,
+		URL:     "http://seller.local/old-owner",
+		Secret:  "0123456789abcdef",
+	})
+	s.Suite.Require().NoError(err, "Can not save old owner webhook")
+	newWebhookID, err := s.repository.SaveWebhook(ctx, model.Webhook{
+		OwnerID: 906,
+		URL:     "http://seller.local/new-owner",
+		Secret:  "0123456789abcdef",
+	})
+	s.Suite.Require().NoError(err, "Can not save new owner webhook")
+	commentID, err := s.repository.SaveComment(ctx, model.Comment{
+		UserID:         473,
+		ProductID:      143,
+		ProductOwnerID: 905,
+		Text:           "Есть ли гарантия?",
+		Recipients:     []model.Recipient{{UserID: 905, Reason: model.ReasonNewComment}},
+	})
+	s.Suite.Require().NoError(err, "Can not save comment")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, commentID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	ntf := notification.CommentNotification{
+		ID:          statuses[0].ID,
+		RecipientID: 905,
+		Reason:      model.ReasonNewComment,
+		CommentID:   commentID,
+		ProductID:   143,
+	}
+	payload, err := json.Marshal(ntf)
+	s.Suite.Require().NoError(err, "Can not marshal notification")
+	err = s.repository.ScheduleWebhookTasks(ctx, ntf, notification.EventCommentCreated, payload)
+	s.Suite.Require().NoError(err, "Can not schedule webhook tasks")
+
+	processed, res, err := s.repository.ChangeProductOwner(ctx, "test", "owner-changed-2", 143, 906)
+	s.Suite.Require().NoError(err, "Can not change owner")
+	s.Suite.Require().True(processed, "Event is not processed")
+	s.Suite.Require().Equal(int64(1), res.WebhookTasks, "Moved webhook tasks mismatch")
+	taskStatus := func(webhookID int64) (string, notification.CommentNotification) {
+		var status string
+		var payload []byte
+		err := s.rwPool.QueryRow(ctx, "SELECT status, payload FROM webhook_tasks WHERE webhook_id = $1",
+			webhookID).Scan(&status, &payload)
+		s.Suite.Require().NoError(err, "Can not get webhook task")
+		var ntf notification.CommentNotification
+		s.Suite.Require().NoError(json.Unmarshal(payload, &ntf), "Can not unmarshal webhook payload")
+		return status, ntf
+	}
+	oldStatus, _ := taskStatus(oldWebhookID)
+	s.Suite.Require().Equal(model.WebhookTaskCanceled, oldStatus, "Old owner task status mismatch")
+	newStatus, moved := taskStatus(newWebhookID)
+	s.Suite.Require().Equal(model.WebhookTaskNew, newStatus, "New owner task status mismatch")
+	s.Suite.Require().Equal(int64(906), moved.RecipientID, "Task recipient mismatch")
+	s.Suite.Require().Equal(commentID, moved.CommentID, "Task comment mismatch")
+}
+
+func (s *RepositoryIntegrationTestSuite) TestPendingCommentValidation() {
+	ctx := context.Background()
+	validID, err := s.repository.SavePendingComment(ctx, model.Comment{
+		UserID:    464,
+		ProductID: 133,
+		Text:      "Принят без проверки",
+	}, time.Now().Add(-time.Second))
+	s.Suite.Require().NoError(err, "Can not save pending comment")
+	invalidID, err := s.repository.SavePendingComment(ctx, model.Comment{
+		UserID:    465,
+		ProductID: 133,
+		Text:      "Автор не существует",
+	}, time.Now().Add(-time.Second))
+	s.Suite.Require().NoError(err, "Can not save pending comment")
+	comments, err := s.repository.GetComments(ctx, 133)
+	s.Suite.Require().NoError(err, "Can not get comments")
+	s.Suite.Require().Equal(0, len(comments), "Pending comments are visible")
+
+	pending, err := s.repository.GetPendingComments(ctx, 10)
+	s.Suite.Require().NoError(err, "Can not get pending comments")
+	s.Suite.Require().Equal(2, len(pending), "Pending comments mismatch")
+	err = s.repository.PostponeCommentValidation(ctx, validID, time.Now().Add(time.Hour))
+	s.Suite.Require().NoError(err, "Can not postpone validation")
+	pending, err = s.repository.GetPendingComments(ctx, 10)
+	s.Suite.Require().NoError(err, "Can not get pending comments")
+	s.Suite.Require().Equal(1, len(pending), "Postponed comment is due")
+
+	valid, err := s.repository.GetComment(ctx, validID)
+	s.Suite.Require().NoError(err, "Can not get pending comment")
+	s.Suite.Require().Equal(model.CommentPendingValidation, valid.Status, "Status mismatch")
+	valid.ProductOwnerID = 800
+	valid.Recipients = []model.Recipient{{UserID: 800, Reason: model.ReasonNewComment}}
+	published, err := s.repository.PublishPendingComment(ctx, valid)
+	s.Suite.Require().NoError(err, "Can not publish comment")
+	s.Suite.Require().True(published, "Comment is not published")
+	published, err = s.repository.PublishPendingComment(ctx, valid)
+	s.Suite.Require().NoError(err, "Can not publish comment twice")
+	s.Suite.Require().False(published, "Comment is published twice")
+	comments, err = s.repository.GetComments(ctx, 133)
+	s.Suite.Require().NoError(err, "Can not get comments")
+	s.Suite.Require().Equal(1, len(comments), "Published comment is hidden")
+	statuses, err := s.repository.GetCommentNotificationStatus(ctx, validID)
+	s.Suite.Require().NoError(err, "Can not get notification status")
+	s.Suite.Require().Equal(int64(800), statuses[0].RecipientID, "Recipient mismatch")
+
+	rejected, err := s.repository.RejectPendingComment(ctx, pending[0], model.RejectIncorrectUser)
+	s.Suite.Require().NoError(err, "Can not reject comment")
+	s.Suite.Require().True(rejected, "Comment is not rejected")
+	statuses, err = s.repository.GetCommentNotificationStatus(ctx, invalidID)
+	s.Suite.Require().NoError(err, "Can not get rejection status")
+	s.Suite.Require().Equal(int64(465), statuses[0].RecipientID, "Rejection recipient mismatch")
+	s.Suite.Require().Equal(model.ReasonCommentRejected, statuses[0].Reason, "Rejection reason mismatch")
+	comments, err = s.repository.GetComments(ctx, 133)
+	s.Suite.Require().NoError(err, "Can not get comments")
+	s.Suite.Require().Equal(1, len(comments), "Rejected comment is visible")
+}
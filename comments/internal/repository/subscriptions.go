@@ -0,0 +1,125 @@
+package repository
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Fan-out task statuses
+const (
+	fanOutStatusNew  = "new"
+	fanOutStatusDone = "done"
+)
+
+func (rep *Repository) SaveSubscription(ctx context.Context, userID int64, productID int64) (bool, error) {
+	r := New(rep.write)
+	inserted, err := r.SaveSubscription(ctx, &SaveSubscriptionParams{
+		UserID:    userID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("save subscription failed: %w", err)
+	}
+	return inserted > 0, nil
+}
+
+func (rep *Repository) DeleteSubscription(ctx context.Context, userID int64, productID int64) (bool, error) {
+	r := New(rep.write)
+	deleted, err := r.DeleteSubscription(ctx, &DeleteSubscriptionParams{
+		UserID:    userID,
+		ProductID: productID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("delete subscription failed: %w", err)
+	}
+	return deleted > 0, nil
+}
+
+func (rep *Repository) GetUserSubscriptions(ctx context.Context, userID int64) ([]model.Subscription, error) {
+	r := New(rep.write)
+	subscriptions, err := r.GetUserSubscriptions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("can not get subscriptions: %w", err)
+	}
+	res := make([]model.Subscription, len(subscriptions))
+	for i, val := range subscriptions {
+		res[i] = model.Subscription{
+			UserID:    val.UserID,
+			ProductID: val.ProductID,
+			CreatedAt: val.CreatedAt.Time,
+		}
+	}
+	return res, nil
+}
+
+func (rep *Repository) GetPendingFanOutTasks(ctx context.Context) ([]model.FanOutTask, error) {
+	r := New(rep.write)
+	tasks, err := r.GetPendingFanOutTasks(ctx, rep.ntfCount)
+	if err != nil {
+		return nil, fmt.Errorf("can not get fan-out tasks: %w", err)
+	}
+	res := make([]model.FanOutTask, len(tasks))
+	for i, val := range tasks {
+		res[i] = model.FanOutTask{
+			CommentID:  val.CommentID,
+			ProductID:  val.ProductID,
+			AuthorID:   val.UserID,
+			CreatedTS:  val.Ts.Time,
+			LastUserID: val.LastUserID,
+		}
+		if val.TraceParent != nil {
+			res[i].TraceParent = *val.TraceParent
+		}
+	}
+	return res, nil
+}
+
+// FanOutBatch enqueues notifications for the next batch of subscribers after task.LastUserID
+// and moves the task cursor in the same transaction. It returns the new cursor and whether
+// all subscribers were notified.
+func (rep *Repository) FanOutBatch(ctx context.Context, task model.FanOutTask, batchSize int32) (int64, bool, error) {
+	lastUserID, done := task.LastUserID, false
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		params := &FanOutSubscribersParams{
+			ProductID:  task.ProductID,
+			LastUserID: task.LastUserID,
+			BatchSize:  batchSize,
+			CommentID:  task.CommentID,
+			Ts: pgtype.Timestamp{
+				Time:  task.CreatedTS,
+				Valid: true,
+			},
+			AuthorID: task.AuthorID,
+		}
+		if task.TraceParent != "" {
+			params.TraceParent = &task.TraceParent
+		}
+		batch, err := r.FanOutSubscribers(ctx, params)
+		if err != nil {
+			return fmt.Errorf("fan out subscribers failed: %w", err)
+		}
+		status := fanOutStatusNew
+		if batch.BatchCount < int64(batchSize) {
+			status = fanOutStatusDone
+		}
+		if batch.BatchCount > 0 {
+			lastUserID = batch.LastUserID
+		}
+		err = r.UpdateFanOutTask(ctx, &UpdateFanOutTaskParams{
+			CommentID:  task.CommentID,
+			LastUserID: lastUserID,
+			Status:     status,
+		})
+		if err != nil {
+			return fmt.Errorf("update fan-out task failed: %w", err)
+		}
+		done = status == fanOutStatusDone
+		return nil
+	})
+	return lastUserID, done, err
+}
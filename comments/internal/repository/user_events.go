@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SetUserBanned hides the comments of a banned user, cancels the pending notifications about
+// them and stops their subscription fan-out. Notifications already handed over are stopped too:
+// their pending webhook tasks are canceled and their unpublished outbox messages are deleted,
+// a message or task that is being sent at that moment may still go out. On unban the comments are shown again, canceled
+// notifications are not resent. The event is recorded in the inbox in the same transaction,
+// a redelivered event changes nothing and returns false.
+func (rep *Repository) SetUserBanned(ctx context.Context, consumer string, eventID string,
+	userID int64, banned bool) (bool, model.BanResult, error) {
+	processed := false
+	res := model.BanResult{}
+	err := pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		inserted, err := r.SaveProcessedEvent(ctx, &SaveProcessedEventParams{
+			Consumer: consumer,
+			EventID:  eventID,
+		})
+		if err != nil {
+			return fmt.Errorf("save processed event failed: %w", err)
+		}
+		if inserted == 0 {
+			return nil
+		}
+		res.Comments, err = r.SetUserCommentsBanned(ctx, &SetUserCommentsBannedParams{
+			UserID:       userID,
+			AuthorBanned: banned,
+		})
+		if err != nil {
+			return fmt.Errorf("set user comments banned failed: %w", err)
+		}
+		if banned {
+			res.Notifications, err = r.CancelUserNotifications(ctx, userID)
+			if err != nil {
+				return fmt.Errorf("cancel user notifications failed: %w", err)
+			}
+			res.WebhookTasks, err = r.CancelUserWebhookTasks(ctx, &CancelUserWebhookTasksParams{
+				UserID:      userID,
+				DigestEvent: notification.EventCommentDigest,
+			})
+			if err != nil {
+				return fmt.Errorf("cancel user webhook tasks failed: %w", err)
+			}
+			res.Messages, err = r.DeleteUserNotificationMessages(ctx, &DeleteUserNotificationMessagesParams{
+				Topic:     notification.TopicNotifications,
+				EventType: notification.EventCommentCreated,
+				UserID:    userID,
+			})
+			if err != nil {
+				return fmt.Errorf("delete user notification messages failed: %w", err)
+			}
+			if _, err = r.CompleteUserFanOutTasks(ctx, userID); err != nil {
+				return fmt.Errorf("complete user fan-out tasks failed: %w", err)
+			}
+		}
+		processed = true
+		return nil
+	})
+	return processed, res, err
+}
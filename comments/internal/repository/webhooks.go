@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"example/comments/internal/external/notification"
+	"example/comments/internal/model"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func (rep *Repository) SaveWebhook(ctx context.Context, webhook model.Webhook) (int64, error) {
+	r := New(rep.write)
+	webhookID, err := r.SaveWebhook(ctx, &SaveWebhookParams{
+		OwnerID: webhook.OwnerID,
+		Url:     webhook.URL,
+		Secret:  webhook.Secret,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("save webhook failed: %w", err)
+	}
+	return webhookID, nil
+}
+
+func (rep *Repository) GetOwnerWebhooks(ctx context.Context, ownerID int64) ([]model.Webhook, error) {
+	r := New(rep.write)
+	webhooks, err := r.GetOwnerWebhooks(ctx, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("can not get webhooks: %w", err)
+	}
+	res := make([]model.Webhook, len(webhooks))
+	for i, val := range webhooks {
+		res[i] = model.Webhook{
+			ID:      val.ID,
+			OwnerID: val.OwnerID,
+			URL:     val.Url,
+			Secret:  val.Secret,
+		}
+	}
+	return res, nil
+}
+
+// GetWebhookPendingNotification applies the recipient preferences like the Kafka publisher does:
+// notifications of muted products are dropped, the ones in quiet hours wait for their end, and
+// the ones of digest owners are left for the digest.
+func (rep *Repository) GetWebhookPendingNotification(ctx context.Context) ([]notification.CommentNotification, error) {
+	r := New(rep.write)
+	if _, err := r.MarkMutedWebhookNotification(ctx); err != nil {
+		return nil, fmt.Errorf("can not mute webhook notifications: %w", err)
+	}
+	ntfsEntity, err := r.GetWebhookPendingNotification(ctx, &GetWebhookPendingNotificationParams{
+		Now:      pgtype.Timestamptz{Time: time.Now(), Valid: true},
+		MaxCount: rep.ntfCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not get webhook notifications: %w", err)
+	}
+	ntfs := make([]notification.CommentNotification, len(ntfsEntity))
+	for i, val := range ntfsEntity {
+		ntfs[i] = notification.CommentNotification{
+			ID:          val.ID,
+			RecipientID: val.RecipientID,
+			Reason:      val.Reason,
+			CommentID:   val.CommentID,
+			CreatedTS:   val.Ts.Time,
+		}
+		if val.TraceParent != nil {
+			ntfs[i].TraceParent = *val.TraceParent
+		}
+		if val.ProductID != nil {
+			ntfs[i].ProductID = *val.ProductID
+		}
+	}
+	return ntfs, nil
+}
+
+// ScheduleWebhookTasks marks the notification done and saves a task for every webhook of the
+// recipient in one transaction. A notification already scheduled by another replica is skipped:
+// marking it waits for that transaction and then affects no rows.
+func (rep *Repository) ScheduleWebhookTasks(ctx context.Context, ntf notification.CommentNotification, event string, payload []byte) error {
+	return pgx.BeginTxFunc(ctx, rep.write, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		r := New(tx)
+		marked, err := r.MarkWebhookNotificationDone(ctx, ntf.ID)
+		if err != nil {
+			return fmt.Errorf("mark webhook notification done failed: %w", err)
+		}
+		if marked == 0 {
+			return nil
+		}
+		return scheduleWebhookTasks(ctx, r, ntf.RecipientID, ntf.ID, event, payload, ntf.TraceParent)
+	})
+}
+
+func scheduleWebhookTasks(ctx context.Context, r *Queries, ownerID, notificationID int64, event string, payload []byte, traceParent string) error {
+	params := &SaveWebhookTasksParams{
+		NotificationID: notificationID,
+		Event:          event,
+		Payload:        payload,
+		OwnerID:        ownerID,
+	}
+	if traceParent != "" {
+		params.TraceParent = &traceParent
+	}
+	if _, err := r.SaveWebhookTasks(ctx, params); err != nil {
+		return fmt.Errorf("save webhook tasks failed: %w", err)
+	}
+	return nil
+}
+
+// ClaimWebhookTasks returns the due tasks and hides them from other claims until leaseUntil.
+func (rep *Repository) ClaimWebhookTasks(ctx context.Context, leaseUntil time.Time) ([]model.WebhookTask, error) {
+	r := New(rep.write)
+	rows, err := r.ClaimWebhookTasks(ctx, &ClaimWebhookTasksParams{
+		LeaseUntil: pgtype.Timestamp{Time: leaseUntil, Valid: true},
+		MaxCount:   rep.ntfCount,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not claim webhook tasks: %w", err)
+	}
+	tasks := make([]model.WebhookTask, len(rows))
+	for i, val := range rows {
+		tasks[i] = model.WebhookTask{
+			ID: val.ID,
+			Webhook: model.Webhook{
+				ID:     val.WebhookID,
+				URL:    val.Url,
+				Secret: val.Secret,
+			},
+			NotificationID: val.NotificationID,
+			Event:          val.Event,
+			Payload:        val.Payload,
+			Attempt:        val.Attempt,
+			Status:         model.WebhookTaskNew,
+		}
+		if val.TraceParent != nil {
+			tasks[i].TraceParent = *val.TraceParent
+		}
+	}
+	slices.SortFunc(tasks, func(a, b model.WebhookTask) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return tasks, nil
+}
+
+func (rep *Repository) SaveWebhookTaskResult(ctx context.Context, task model.WebhookTask) error {
+	r := New(rep.write)
+	return r.SaveWebhookTaskResult(ctx, &SaveWebhookTaskResultParams{
+		ID:            task.ID,
+		Attempt:       task.Attempt,
+		Status:        task.Status,
+		NextAttemptAt: pgtype.Timestamp{Time: task.NextAttemptTS, Valid: true},
+	})
+}
+
+// RescheduleWebhookTasks returns claimed tasks that were not attempted, their attempts are kept.
+func (rep *Repository) RescheduleWebhookTasks(ctx context.Context, ids []int64, at time.Time) error {
+	r := New(rep.write)
+	return r.RescheduleWebhookTasks(ctx, &RescheduleWebhookTasksParams{
+		NextAttemptAt: pgtype.Timestamp{Time: at, Valid: true},
+		Ids:           ids,
+	})
+}
+
+func (rep *Repository) SaveWebhookDelivery(ctx context.Context, delivery model.WebhookDelivery) error {
+	r := New(rep.write)
+	return r.SaveWebhookDelivery(ctx, &SaveWebhookDeliveryParams{
+		WebhookID:      delivery.WebhookID,
+		NotificationID: delivery.NotificationID,
+		Attempt:        delivery.Attempt,
+		StatusCode:     delivery.StatusCode,
+		Error:          delivery.Error,
+		Success:        delivery.Success,
+	})
+}
+
+func (rep *Repository) GetWebhookDeliveries(ctx context.Context, ownerID int64, limit int32) ([]model.WebhookDelivery, error) {
+	r := New(rep.write)
+	deliveries, err := r.GetWebhookDeliveries(ctx, &GetWebhookDeliveriesParams{
+		OwnerID: ownerID,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can not get webhook deliveries: %w", err)
+	}
+	res := make([]model.WebhookDelivery, len(deliveries))
+	for i, val := range deliveries {
+		res[i] = model.WebhookDelivery{
+			ID:             val.ID,
+			WebhookID:      val.WebhookID,
+			URL:            val.Url,
+			NotificationID: val.NotificationID,
+			Attempt:        val.Attempt,
+			StatusCode:     val.StatusCode,
+			Error:          val.Error,
+			Success:        val.Success,
+			Ts:             val.Ts.Time,
+		}
+	}
+	return res, nil
+}
@@ -7,15 +7,19 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	trace2 "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
+const traceParentHeader = "traceparent"
+
 var (
 	tracerProvider trace.TracerProvider
 	tracer         trace.Tracer
+	propagator     = propagation.TraceContext{}
 )
 
 func CreateTracerProvider(ctx context.Context, config *config.Config) {
@@ -40,9 +44,36 @@ func CreateTracerProvider(ctx context.Context, config *config.Config) {
 		trace2.WithResource(jaegerResource),
 	)
 	otel.SetTracerProvider(tracerProvider)
+	otel.SetTextMapPropagator(propagator)
 	tracer = otel.GetTracerProvider().Tracer("cart_service")
 }
 
 func Tracer() trace.Tracer {
+	if tracer == nil {
+		return otel.GetTracerProvider().Tracer("cart_service")
+	}
 	return tracer
 }
+
+func Propagator() propagation.TextMapPropagator {
+	return propagator
+}
+
+// TraceParent returns the W3C traceparent of the span stored in ctx or nil if there is no span.
+func TraceParent(ctx context.Context) *string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	traceParent := carrier.Get(traceParentHeader)
+	if traceParent == "" {
+		return nil
+	}
+	return &traceParent
+}
+
+// ContextWithTraceParent restores the remote span described by a W3C traceparent into ctx.
+func ContextWithTraceParent(ctx context.Context, traceParent string) context.Context {
+	if traceParent == "" {
+		return ctx
+	}
+	return propagator.Extract(ctx, propagation.MapCarrier{traceParentHeader: traceParent})
+}
@@ -3,11 +3,18 @@ package usecases
 import (
 	"context"
 	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
 	"example/comments/internal/model"
+	"time"
+
+	"golang.org/x/sync/errgroup"
 )
 
 type SaveCommentRepository interface {
 	SaveComment(_ context.Context, comment model.Comment) (int64, error)
+	SavePendingComment(_ context.Context, comment model.Comment, validateAt time.Time) (int64, error)
+	GetComment(_ context.Context, commentID int64) (model.Comment, error)
 }
 
 type UserService interface {
@@ -18,36 +25,181 @@ type ProductsService interface {
 	GetProductOwner(_ context.Context, productID int64) (int64, error)
 }
 
+// DegradedConfig enables accepting comments while the users or products service is
+// unavailable. Such a comment is saved pending validation and checked by PendingValidator
+// after Backoff, the delay doubles with every failed attempt up to MaxBackoff.
+type DegradedConfig struct {
+	Enabled    bool
+	Timer      time.Duration
+	BatchSize  int
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+}
+
+// backoff returns the delay before the validation attempt that follows the failed ones.
+func (c DegradedConfig) backoff(failed int) time.Duration {
+	delay := c.Backoff
+	for i := 0; i < failed && delay < c.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, c.MaxBackoff)
+}
+
 type CreateCommentService struct {
 	rep            SaveCommentRepository
 	userService    UserService
 	productService ProductsService
+	degraded       DegradedConfig
 }
 
-func NewCreateCommentService(rep SaveCommentRepository, products ProductsService, users UserService) *CreateCommentService {
+func NewCreateCommentService(rep SaveCommentRepository, products ProductsService, users UserService, degraded DegradedConfig) *CreateCommentService {
 	return &CreateCommentService{
 		rep:            rep,
 		productService: products,
 		userService:    users,
+		degraded:       degraded,
 	}
 }
 
-func (s *CreateCommentService) CreateComment(ctx context.Context, comment model.Comment) (int64, error) {
-	isCorrectUserID, err := s.userService.CheckUserID(ctx, comment.UserID)
+// CreateComment saves the comment and returns its id and status. In degraded mode a comment
+// that can not be validated because of an unavailable service is saved pending validation.
+func (s *CreateCommentService) CreateComment(ctx context.Context, comment model.Comment) (int64, string, error) {
+	productOwnerID, mentioned, err := s.validate(ctx, comment)
+	if err != nil {
+		if s.degraded.Enabled && unavailable(err) {
+			return s.savePending(ctx, comment, err)
+		}
+		return 0, "", err
+	}
+	comment.ProductOwnerID = productOwnerID
+	comment.Recipients, err = s.recipients(ctx, comment, mentioned)
 	if err != nil {
-		return 0, errors.Join(model.ErrUserServiceUnavailable, err)
+		return 0, "", err
 	}
-	if !isCorrectUserID {
-		return 0, model.ErrIncorrectUserID
+	commentID, err := s.rep.SaveComment(ctx, comment)
+	return commentID, model.CommentPublished, err
+}
+
+// savePending saves the comment for the validator. The replied comment is in the database,
+// so it is checked at once.
+func (s *CreateCommentService) savePending(ctx context.Context, comment model.Comment, cause error) (int64, string, error) {
+	if _, err := s.parent(ctx, comment); err != nil {
+		return 0, "", err
 	}
-	productOwnerID, err := s.productService.GetProductOwner(ctx, comment.ProductID)
+	commentID, err := s.rep.SavePendingComment(ctx, comment, time.Now().Add(s.degraded.backoff(0)))
 	if err != nil {
-		return 0, errors.Join(model.ErrProductServiceUnavailable, err)
+		return 0, "", err
 	}
-	if productOwnerID == 0 {
-		return 0, model.ErrProductOwnerNotFound
+	metrics.IncDegradedComments(metrics.DegradedAccepted)
+	logger.Warnw(ctx, "comment accepted pending validation", "comment_id", commentID, "error", cause.Error())
+	return commentID, model.CommentPendingValidation, nil
+}
+
+// validate checks the author, looks up the product owner and checks the mentioned users
+// concurrently. It returns the owner and the mentioned users that exist. The first failure
+// cancels the other lookups and is returned, so a canceled lookup never hides the real error.
+func (s *CreateCommentService) validate(ctx context.Context, comment model.Comment) (int64, []int64, error) {
+	var productOwnerID int64
+	mentions := make([]int64, 0)
+	for _, userID := range parseMentions(comment.Text) {
+		if userID != comment.UserID {
+			mentions = append(mentions, userID)
+		}
 	}
-	comment.ProductOwnerID = productOwnerID
-	commentID, err := s.rep.SaveComment(ctx, comment)
-	return commentID, err
+	exists := make([]bool, len(mentions))
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		isCorrectUserID, err := s.userService.CheckUserID(gctx, comment.UserID)
+		if err != nil {
+			return errors.Join(model.ErrUserServiceUnavailable, err)
+		}
+		if !isCorrectUserID {
+			return model.ErrIncorrectUserID
+		}
+		return nil
+	})
+	g.Go(func() error {
+		ownerID, err := s.productService.GetProductOwner(gctx, comment.ProductID)
+		if err != nil {
+			return errors.Join(model.ErrProductServiceUnavailable, err)
+		}
+		if ownerID == 0 {
+			return model.ErrProductOwnerNotFound
+		}
+		productOwnerID = ownerID
+		return nil
+	})
+	for i, userID := range mentions {
+		g.Go(func() error {
+			isCorrectUserID, err := s.userService.CheckUserID(gctx, userID)
+			if err != nil {
+				return errors.Join(model.ErrUserServiceUnavailable, err)
+			}
+			exists[i] = isCorrectUserID
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return 0, nil, err
+	}
+	mentioned := make([]int64, 0, len(mentions))
+	for i, userID := range mentions {
+		if exists[i] {
+			mentioned = append(mentioned, userID)
+		}
+	}
+	return productOwnerID, mentioned, nil
+}
+
+// recipients resolves who is notified about the comment: the product owner, the author of
+// the replied comment and the mentioned users checked by validate. Every user gets one
+// notification with the most specific reason, the comment author is never notified.
+func (s *CreateCommentService) recipients(ctx context.Context, comment model.Comment, mentioned []int64) ([]model.Recipient, error) {
+	recipients := make([]model.Recipient, 0)
+	add := func(userID int64, reason string) {
+		if userID == comment.UserID {
+			return
+		}
+		for _, val := range recipients {
+			if val.UserID == userID {
+				return
+			}
+		}
+		recipients = append(recipients, model.Recipient{UserID: userID, Reason: reason})
+	}
+
+	if comment.ParentID != 0 {
+		parent, err := s.parent(ctx, comment)
+		if err != nil {
+			return nil, err
+		}
+		if comment.UserID == comment.ProductOwnerID {
+			add(parent.UserID, model.ReasonOwnerAnswer)
+		} else {
+			add(parent.UserID, model.ReasonReply)
+		}
+	}
+	add(comment.ProductOwnerID, model.ReasonNewComment)
+	for _, userID := range mentioned {
+		add(userID, model.ReasonMention)
+	}
+	return recipients, nil
+}
+
+// parent returns the replied comment, it must be a published comment of the same product.
+func (s *CreateCommentService) parent(ctx context.Context, comment model.Comment) (model.Comment, error) {
+	if comment.ParentID == 0 {
+		return model.Comment{}, nil
+	}
+	parent, err := s.rep.GetComment(ctx, comment.ParentID)
+	if errors.Is(err, model.ErrCommentNotFound) ||
+		(err == nil && (parent.ProductID != comment.ProductID || parent.Status != model.CommentPublished)) {
+		return model.Comment{}, model.ErrParentCommentNotFound
+	}
+	return parent, err
+}
+
+// unavailable reports whether the error is caused by an unavailable external service.
+func unavailable(err error) bool {
+	return errors.Is(err, model.ErrUserServiceUnavailable) || errors.Is(err, model.ErrProductServiceUnavailable)
 }
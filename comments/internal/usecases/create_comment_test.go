@@ -0,0 +1,265 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// lookupLatency is the latency of a fake external service in the benchmarks.
+const lookupLatency = time.Millisecond
+
+type saveRepStub struct {
+	saved   model.Comment
+	pending model.Comment
+	parent  *model.Comment
+}
+
+func (r *saveRepStub) SaveComment(_ context.Context, comment model.Comment) (int64, error) {
+	r.saved = comment
+	return 1, nil
+}
+
+func (r *saveRepStub) SavePendingComment(_ context.Context, comment model.Comment, _ time.Time) (int64, error) {
+	r.pending = comment
+	return 2, nil
+}
+
+func (r *saveRepStub) GetComment(_ context.Context, _ int64) (model.Comment, error) {
+	if r.parent == nil {
+		return model.Comment{}, model.ErrCommentNotFound
+	}
+	return *r.parent, nil
+}
+
+// usersFake answers after the latency, with block set it waits for the cancellation.
+// Unknown users do not exist and failing users can not be checked.
+type usersFake struct {
+	latency time.Duration
+	block   bool
+	correct bool
+	err     error
+	unknown map[int64]bool
+	failing map[int64]bool
+}
+
+func (u *usersFake) CheckUserID(ctx context.Context, userID int64) (bool, error) {
+	if err := wait(ctx, u.latency, u.block); err != nil {
+		return false, err
+	}
+	if u.failing[userID] {
+		return false, errors.New("unavailable")
+	}
+	if u.unknown[userID] {
+		return false, nil
+	}
+	return u.correct, u.err
+}
+
+type productsFake struct {
+	latency time.Duration
+	block   bool
+	ownerID int64
+	err     error
+}
+
+func (p *productsFake) GetProductOwner(ctx context.Context, _ int64) (int64, error) {
+	if err := wait(ctx, p.latency, p.block); err != nil {
+		return 0, err
+	}
+	return p.ownerID, p.err
+}
+
+func wait(ctx context.Context, latency time.Duration, block bool) error {
+	if block {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	if latency == 0 {
+		return nil
+	}
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func TestCreateComment(t *testing.T) {
+	rep := &saveRepStub{}
+	service := NewCreateCommentService(rep, &productsFake{ownerID: 7}, &usersFake{correct: true}, DegradedConfig{})
+
+	commentID, commentStatus, err := service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2, Text: "text"})
+	require.NoError(t, err, "CreateComment failed")
+	require.Equal(t, int64(1), commentID, "Comment id mismatch")
+	require.Equal(t, model.CommentPublished, commentStatus, "Status mismatch")
+	require.Equal(t, int64(7), rep.saved.ProductOwnerID, "Product owner mismatch")
+	require.Equal(t, []model.Recipient{{UserID: 7, Reason: model.ReasonNewComment}}, rep.saved.Recipients, "Recipients mismatch")
+}
+
+func TestCreateCommentValidationErrors(t *testing.T) {
+	unavailable := errors.New("unavailable")
+	tests := []struct {
+		name     string
+		users    *usersFake
+		products *productsFake
+		err      error
+	}{
+		{"incorrect user cancels product lookup", &usersFake{correct: false}, &productsFake{block: true}, model.ErrIncorrectUserID},
+		{"users unavailable", &usersFake{err: unavailable}, &productsFake{block: true}, model.ErrUserServiceUnavailable},
+		{"owner not found cancels user check", &usersFake{block: true}, &productsFake{ownerID: 0}, model.ErrProductOwnerNotFound},
+		{"products unavailable", &usersFake{block: true}, &productsFake{err: unavailable}, model.ErrProductServiceUnavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rep := &saveRepStub{}
+			service := NewCreateCommentService(rep, tt.products, tt.users, DegradedConfig{})
+
+			_, _, err := service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2})
+			require.ErrorIs(t, err, tt.err, "Error mismatch")
+			require.NotErrorIs(t, err, context.Canceled, "Canceled lookup hides the error")
+			require.Zero(t, rep.saved.UserID, "Invalid comment is saved")
+		})
+	}
+}
+
+func TestCreateCommentDegraded(t *testing.T) {
+	unavailable := errors.New("unavailable")
+	degraded := DegradedConfig{Enabled: true, Backoff: time.Second, MaxBackoff: time.Minute}
+	rep := &saveRepStub{}
+	service := NewCreateCommentService(rep, &productsFake{err: unavailable}, &usersFake{correct: true}, degraded)
+
+	commentID, commentStatus, err := service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2, Text: "text"})
+	require.NoError(t, err, "Comment is not accepted in degraded mode")
+	require.Equal(t, int64(2), commentID, "Comment id mismatch")
+	require.Equal(t, model.CommentPendingValidation, commentStatus, "Status mismatch")
+	require.Equal(t, int64(1), rep.pending.UserID, "Pending comment mismatch")
+	require.Zero(t, rep.saved.UserID, "Pending comment is published")
+
+	rep.parent = &model.Comment{ID: 5, ProductID: 3, Status: model.CommentPublished}
+	_, _, err = service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2, ParentID: 5, Text: "text"})
+	require.ErrorIs(t, err, model.ErrParentCommentNotFound, "Parent of another product is accepted")
+
+	service = NewCreateCommentService(rep, &productsFake{ownerID: 0}, &usersFake{block: true}, degraded)
+	_, _, err = service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2, Text: "text"})
+	require.ErrorIs(t, err, model.ErrProductOwnerNotFound, "Invalid comment is accepted in degraded mode")
+}
+
+func TestRecipients(t *testing.T) {
+	tests := []struct {
+		name       string
+		comment    model.Comment
+		parent     *model.Comment
+		recipients []model.Recipient
+	}{
+		{
+			name:       "owner",
+			comment:    model.Comment{UserID: 1, ProductID: 2, Text: "text"},
+			recipients: []model.Recipient{{UserID: 7, Reason: model.ReasonNewComment}},
+		},
+		{
+			name:    "reply",
+			comment: model.Comment{UserID: 1, ProductID: 2, ParentID: 5, Text: "text"},
+			parent:  &model.Comment{ID: 5, UserID: 3, ProductID: 2, Status: model.CommentPublished},
+			recipients: []model.Recipient{
+				{UserID: 3, Reason: model.ReasonReply},
+				{UserID: 7, Reason: model.ReasonNewComment},
+			},
+		},
+		{
+			name:       "owner answer",
+			comment:    model.Comment{UserID: 7, ProductID: 2, ParentID: 5, Text: "text"},
+			parent:     &model.Comment{ID: 5, UserID: 3, ProductID: 2, Status: model.CommentPublished},
+			recipients: []model.Recipient{{UserID: 3, Reason: model.ReasonOwnerAnswer}},
+		},
+		{
+			name:    "mentions are deduplicated with the most specific reason",
+			comment: model.Comment{UserID: 1, ProductID: 2, ParentID: 5, Text: "@3 @7 @8 @8"},
+			parent:  &model.Comment{ID: 5, UserID: 3, ProductID: 2, Status: model.CommentPublished},
+			recipients: []model.Recipient{
+				{UserID: 3, Reason: model.ReasonReply},
+				{UserID: 7, Reason: model.ReasonNewComment},
+				{UserID: 8, Reason: model.ReasonMention},
+			},
+		},
+		{
+			name:       "author is not notified",
+			comment:    model.Comment{UserID: 3, ProductID: 2, ParentID: 5, Text: "@3 @9"},
+			parent:     &model.Comment{ID: 5, UserID: 3, ProductID: 2, Status: model.CommentPublished},
+			recipients: []model.Recipient{{UserID: 7, Reason: model.ReasonNewComment}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rep := &saveRepStub{parent: tt.parent}
+			users := &usersFake{correct: true, unknown: map[int64]bool{9: true}}
+			service := NewCreateCommentService(rep, &productsFake{ownerID: 7}, users, DegradedConfig{})
+
+			_, _, err := service.CreateComment(context.Background(), tt.comment)
+			require.NoError(t, err, "CreateComment failed")
+			require.Equal(t, tt.recipients, rep.saved.Recipients, "Recipients mismatch")
+		})
+	}
+}
+
+func TestCreateCommentMentionsUnavailable(t *testing.T) {
+	users := &usersFake{correct: true, failing: map[int64]bool{8: true}}
+	rep := &saveRepStub{}
+	service := NewCreateCommentService(rep, &productsFake{ownerID: 7}, users, DegradedConfig{})
+	_, _, err := service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2, Text: "@8"})
+	require.ErrorIs(t, err, model.ErrUserServiceUnavailable, "Error mismatch")
+
+	degraded := DegradedConfig{Enabled: true, Backoff: time.Second, MaxBackoff: time.Minute}
+	service = NewCreateCommentService(rep, &productsFake{ownerID: 7}, users, degraded)
+	_, commentStatus, err := service.CreateComment(context.Background(), model.Comment{UserID: 1, ProductID: 2, Text: "@8"})
+	require.NoError(t, err, "Comment is not accepted in degraded mode")
+	require.Equal(t, model.CommentPendingValidation, commentStatus, "Status mismatch")
+}
+
+func TestDegradedBackoff(t *testing.T) {
+	conf := DegradedConfig{Backoff: time.Second, MaxBackoff: 5 * time.Second}
+	require.Equal(t, time.Second, conf.backoff(0), "First backoff mismatch")
+	require.Equal(t, 4*time.Second, conf.backoff(2), "Backoff mismatch")
+	require.Equal(t, 5*time.Second, conf.backoff(10), "Max backoff mismatch")
+}
+
+// BenchmarkCreateComment measures CreateComment with both lookups taking lookupLatency, it
+// should take about one latency instead of two.
+func BenchmarkCreateComment(b *testing.B) {
+	service := NewCreateCommentService(&saveRepStub{},
+		&productsFake{latency: lookupLatency, ownerID: 7},
+		&usersFake{latency: lookupLatency, correct: true}, DegradedConfig{})
+	comment := model.Comment{UserID: 1, ProductID: 2, Text: "text"}
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := service.CreateComment(ctx, comment); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkSequentialValidation is the baseline: the same lookups made one after another.
+func BenchmarkSequentialValidation(b *testing.B) {
+	users := &usersFake{latency: lookupLatency, correct: true}
+	products := &productsFake{latency: lookupLatency, ownerID: 7}
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := users.CheckUserID(ctx, 1); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := products.GetProductOwner(ctx, 2); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package usecases
 
 import (
 	"context"
+	"example/comments/internal/logger"
 	"example/comments/internal/model"
 )
 
@@ -9,13 +10,19 @@ type GetCommentsRepository interface {
 	GetComments(_ context.Context, productID int64) ([]model.Comment, error)
 }
 
+type UserProfileService interface {
+	GetUserProfiles(ctx context.Context, userIDs []int64) (map[int64]model.UserProfile, error)
+}
+
 type GetCommentsService struct {
-	rep GetCommentsRepository
+	rep      GetCommentsRepository
+	profiles UserProfileService
 }
 
-func NewGetCommentsService(rep GetCommentsRepository) *GetCommentsService {
+func NewGetCommentsService(rep GetCommentsRepository, profiles UserProfileService) *GetCommentsService {
 	return &GetCommentsService{
-		rep: rep,
+		rep:      rep,
+		profiles: profiles,
 	}
 }
 
@@ -23,3 +30,32 @@ func (service *GetCommentsService) GetComments(ctx context.Context, productID in
 	commentID, err := service.rep.GetComments(ctx, productID)
 	return commentID, err
 }
+
+// GetCommentsWithAuthors adds the author profiles to the comments. If the profiles can not be
+// loaded the comments are returned without authors and false, a comment of an unknown user has
+// no author.
+func (service *GetCommentsService) GetCommentsWithAuthors(ctx context.Context, productID int64) ([]model.Comment, bool, error) {
+	comments, err := service.rep.GetComments(ctx, productID)
+	if err != nil || len(comments) == 0 {
+		return comments, true, err
+	}
+	userIDs := make([]int64, 0, len(comments))
+	seen := make(map[int64]struct{}, len(comments))
+	for _, comment := range comments {
+		if _, ok := seen[comment.UserID]; !ok {
+			seen[comment.UserID] = struct{}{}
+			userIDs = append(userIDs, comment.UserID)
+		}
+	}
+	profiles, err := service.profiles.GetUserProfiles(ctx, userIDs)
+	if err != nil {
+		logger.Warnw(ctx, "user profiles unavailable", "product_id", productID, "error", err)
+		return comments, false, nil
+	}
+	for i := range comments {
+		if profile, ok := profiles[comments[i].UserID]; ok {
+			comments[i].Author = &profile
+		}
+	}
+	return comments, true, nil
+}
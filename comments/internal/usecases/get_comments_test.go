@@ -0,0 +1,60 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type commentsRepStub struct {
+	comments []model.Comment
+}
+
+func (r *commentsRepStub) GetComments(_ context.Context, _ int64) ([]model.Comment, error) {
+	return r.comments, nil
+}
+
+type profilesStub struct {
+	profiles map[int64]model.UserProfile
+	err      error
+	userIDs  []int64
+}
+
+func (p *profilesStub) GetUserProfiles(_ context.Context, userIDs []int64) (map[int64]model.UserProfile, error) {
+	p.userIDs = userIDs
+	return p.profiles, p.err
+}
+
+func TestGetCommentsWithAuthors(t *testing.T) {
+	rep := &commentsRepStub{comments: []model.Comment{
+		{ID: 1, UserID: 10},
+		{ID: 2, UserID: 11},
+		{ID: 3, UserID: 10},
+	}}
+	profiles := &profilesStub{profiles: map[int64]model.UserProfile{
+		10: {UserID: 10, DisplayName: "Анна", AvatarURL: "https://avatars.example.com/10.png"},
+	}}
+	service := NewGetCommentsService(rep, profiles)
+
+	comments, complete, err := service.GetCommentsWithAuthors(context.Background(), 1)
+	require.NoError(t, err, "GetCommentsWithAuthors failed")
+	require.True(t, complete, "Authors complete mismatch")
+	require.Equal(t, []int64{10, 11}, profiles.userIDs, "Requested users mismatch")
+	require.Equal(t, "Анна", comments[0].Author.DisplayName, "Author mismatch")
+	require.Nil(t, comments[1].Author, "Unknown user has author")
+	require.Equal(t, "Анна", comments[2].Author.DisplayName, "Author mismatch")
+}
+
+func TestGetCommentsWithAuthorsUnavailable(t *testing.T) {
+	rep := &commentsRepStub{comments: []model.Comment{{ID: 1, UserID: 10}}}
+	service := NewGetCommentsService(rep, &profilesStub{err: errors.New("unavailable")})
+
+	comments, complete, err := service.GetCommentsWithAuthors(context.Background(), 1)
+	require.NoError(t, err, "Profiles failure fails the listing")
+	require.False(t, complete, "Authors complete mismatch")
+	require.Equal(t, 1, len(comments), "Comments mismatch")
+	require.Nil(t, comments[0].Author, "Author mismatch")
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/model"
+)
+
+type GetNotificationPreferencesRepository interface {
+	GetNotificationPreferences(_ context.Context, ownerID int64) (model.NotificationPreferences, error)
+}
+
+type GetNotificationPreferencesService struct {
+	rep GetNotificationPreferencesRepository
+}
+
+func NewGetNotificationPreferencesService(rep GetNotificationPreferencesRepository) *GetNotificationPreferencesService {
+	return &GetNotificationPreferencesService{
+		rep: rep,
+	}
+}
+
+// GetNotificationPreferences returns immediate delivery for owners who never set preferences.
+func (s *GetNotificationPreferencesService) GetNotificationPreferences(ctx context.Context, ownerID int64) (model.NotificationPreferences, error) {
+	prefs, err := s.rep.GetNotificationPreferences(ctx, ownerID)
+	if errors.Is(err, model.ErrNotificationPreferencesNotFound) {
+		return model.NotificationPreferences{
+			OwnerID: ownerID,
+			Mode:    model.ModeImmediate,
+		}, nil
+	}
+	return prefs, err
+}
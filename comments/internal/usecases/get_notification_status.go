@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+)
+
+type GetNotificationStatusRepository interface {
+	GetCommentNotificationStatus(_ context.Context, commentID int64) ([]model.NotificationStatus, error)
+}
+
+type GetNotificationStatusService struct {
+	rep GetNotificationStatusRepository
+}
+
+func NewGetNotificationStatusService(rep GetNotificationStatusRepository) *GetNotificationStatusService {
+	return &GetNotificationStatusService{
+		rep: rep,
+	}
+}
+
+func (s *GetNotificationStatusService) GetNotificationStatus(ctx context.Context, commentID int64) ([]model.NotificationStatus, error) {
+	return s.rep.GetCommentNotificationStatus(ctx, commentID)
+}
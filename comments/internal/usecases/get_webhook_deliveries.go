@@ -0,0 +1,29 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+)
+
+const defaultWebhookDeliveriesLimit = 50
+
+type GetWebhookDeliveriesRepository interface {
+	GetWebhookDeliveries(_ context.Context, ownerID int64, limit int32) ([]model.WebhookDelivery, error)
+}
+
+type GetWebhookDeliveriesService struct {
+	rep GetWebhookDeliveriesRepository
+}
+
+func NewGetWebhookDeliveriesService(rep GetWebhookDeliveriesRepository) *GetWebhookDeliveriesService {
+	return &GetWebhookDeliveriesService{
+		rep: rep,
+	}
+}
+
+func (s *GetWebhookDeliveriesService) GetWebhookDeliveries(ctx context.Context, ownerID int64, limit int32) ([]model.WebhookDelivery, error) {
+	if limit == 0 {
+		limit = defaultWebhookDeliveriesLimit
+	}
+	return s.rep.GetWebhookDeliveries(ctx, ownerID, limit)
+}
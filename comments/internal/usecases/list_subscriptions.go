@@ -0,0 +1,24 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+)
+
+type GetSubscriptionsRepository interface {
+	GetUserSubscriptions(_ context.Context, userID int64) ([]model.Subscription, error)
+}
+
+type ListSubscriptionsService struct {
+	rep GetSubscriptionsRepository
+}
+
+func NewListSubscriptionsService(rep GetSubscriptionsRepository) *ListSubscriptionsService {
+	return &ListSubscriptionsService{
+		rep: rep,
+	}
+}
+
+func (s *ListSubscriptionsService) ListSubscriptions(ctx context.Context, userID int64) ([]model.Subscription, error) {
+	return s.rep.GetUserSubscriptions(ctx, userID)
+}
@@ -0,0 +1,33 @@
+package usecases
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// maxMentions bounds the user lookups made for a single comment.
+const maxMentions = 10
+
+var mentionRe = regexp.MustCompile(`(?:^|[^\w@])@(\d{1,18})\b`)
+
+// parseMentions returns unique user IDs mentioned as @userID in order of appearance.
+func parseMentions(text string) []int64 {
+	matches := mentionRe.FindAllStringSubmatch(text, -1)
+	userIDs := make([]int64, 0, len(matches))
+	seen := make(map[int64]struct{}, len(matches))
+	for _, match := range matches {
+		userID, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || userID <= 0 {
+			continue
+		}
+		if _, ok := seen[userID]; ok {
+			continue
+		}
+		seen[userID] = struct{}{}
+		userIDs = append(userIDs, userID)
+		if len(userIDs) == maxMentions {
+			break
+		}
+	}
+	return userIDs
+}
@@ -0,0 +1,13 @@
+package usecases
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMentions(t *testing.T) {
+	require.Equal(t, []int64{12, 7}, parseMentions("@12 согласен, @7 и @12 тоже"), "Mentions mismatch")
+	require.Empty(t, parseMentions("mail@12.ru, @@5, @0 и @abc"), "Not mentions parsed")
+	require.Len(t, parseMentions("@1 @2 @3 @4 @5 @6 @7 @8 @9 @10 @11 @12"), maxMentions, "Mentions are not bounded")
+}
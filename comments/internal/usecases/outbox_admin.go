@@ -0,0 +1,86 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/logger"
+	"example/comments/internal/model"
+	"fmt"
+	"slices"
+)
+
+const defaultOutboxLimit = 100
+
+type OutboxAdminRepository interface {
+	GetOutboxRecords(_ context.Context, filter model.OutboxFilter) ([]model.OutboxRecord, error)
+	ReplayNotifications(_ context.Context, filter model.OutboxFilter, topic string, dryRun bool) ([]model.OutboxRecord, error)
+	GetOutboxMessages(_ context.Context, filter model.OutboxMessageFilter) ([]model.OutboxMessage, error)
+	ReplayOutboxMessages(_ context.Context, filter model.OutboxMessageFilter, dryRun bool) ([]model.OutboxMessage, error)
+}
+
+type OutboxAdminService struct {
+	rep OutboxAdminRepository
+	// topics are the logical outbox topics notifications can be replayed to
+	topics []string
+}
+
+func NewOutboxAdminService(rep OutboxAdminRepository, topics []string) *OutboxAdminService {
+	return &OutboxAdminService{
+		rep:    rep,
+		topics: topics,
+	}
+}
+
+func (s *OutboxAdminService) ListOutbox(ctx context.Context, filter model.OutboxFilter) ([]model.OutboxRecord, error) {
+	return s.rep.GetOutboxRecords(ctx, withOutboxLimit(filter))
+}
+
+// ReplayOutbox enqueues at most filter.Limit notifications again to a configured logical
+// topic, a larger range is replayed by repeating the call from the id after the last
+// replayed one.
+func (s *OutboxAdminService) ReplayOutbox(ctx context.Context, filter model.OutboxFilter, topic string, dryRun bool) ([]model.OutboxRecord, error) {
+	if topic != "" && !slices.Contains(s.topics, topic) {
+		return nil, fmt.Errorf("%w: %s", model.ErrUnknownOutboxTopic, topic)
+	}
+	records, err := s.rep.ReplayNotifications(ctx, withOutboxLimit(filter), topic, dryRun)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infow(ctx, "outbox replay",
+		"topic", topic,
+		"dry_run", dryRun,
+		"count", len(records))
+	return records, nil
+}
+
+func (s *OutboxAdminService) ListOutboxMessages(ctx context.Context, filter model.OutboxMessageFilter) ([]model.OutboxMessage, error) {
+	return s.rep.GetOutboxMessages(ctx, withOutboxMessageLimit(filter))
+}
+
+// ReplayOutboxMessages enqueues copies of at most filter.Limit generic outbox messages.
+func (s *OutboxAdminService) ReplayOutboxMessages(ctx context.Context, filter model.OutboxMessageFilter, dryRun bool) ([]model.OutboxMessage, error) {
+	msgs, err := s.rep.ReplayOutboxMessages(ctx, withOutboxMessageLimit(filter), dryRun)
+	if err != nil {
+		return nil, err
+	}
+	logger.Infow(ctx, "outbox messages replay",
+		"topic", filter.Topic,
+		"dry_run", dryRun,
+		"count", len(msgs))
+	return msgs, nil
+}
+
+func withOutboxLimit(filter model.OutboxFilter) model.OutboxFilter {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultOutboxLimit
+	}
+	filter.Limit = min(filter.Limit, model.MaxOutboxRecords)
+	return filter
+}
+
+func withOutboxMessageLimit(filter model.OutboxMessageFilter) model.OutboxMessageFilter {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultOutboxLimit
+	}
+	filter.Limit = min(filter.Limit, model.MaxOutboxRecords)
+	return filter
+}
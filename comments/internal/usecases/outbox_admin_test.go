@@ -0,0 +1,49 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type outboxAdminRepStub struct {
+	topic   string
+	replays int
+}
+
+func (r *outboxAdminRepStub) GetOutboxRecords(_ context.Context, _ model.OutboxFilter) ([]model.OutboxRecord, error) {
+	return nil, nil
+}
+
+func (r *outboxAdminRepStub) ReplayNotifications(_ context.Context, filter model.OutboxFilter, topic string, _ bool) ([]model.OutboxRecord, error) {
+	r.topic = topic
+	r.replays++
+	return []model.OutboxRecord{{ID: filter.FromID}}, nil
+}
+
+func (r *outboxAdminRepStub) GetOutboxMessages(_ context.Context, _ model.OutboxMessageFilter) ([]model.OutboxMessage, error) {
+	return nil, nil
+}
+
+func (r *outboxAdminRepStub) ReplayOutboxMessages(_ context.Context, _ model.OutboxMessageFilter, _ bool) ([]model.OutboxMessage, error) {
+	return nil, nil
+}
+
+func TestReplayOutboxTopics(t *testing.T) {
+	rep := &outboxAdminRepStub{}
+	service := NewOutboxAdminService(rep, []string{"notifications", "comment-events"})
+
+	_, err := service.ReplayOutbox(context.Background(), model.OutboxFilter{FromID: 1}, "loms.order-events", false)
+	require.ErrorIs(t, err, model.ErrUnknownOutboxTopic, "Unknown topic is accepted")
+	require.Equal(t, 0, rep.replays, "Unknown topic is replayed")
+
+	_, err = service.ReplayOutbox(context.Background(), model.OutboxFilter{FromID: 1}, "comment-events", false)
+	require.NoError(t, err, "Configured topic is rejected")
+	require.Equal(t, "comment-events", rep.topic, "Topic mismatch")
+
+	_, err = service.ReplayOutbox(context.Background(), model.OutboxFilter{FromID: 1}, "", false)
+	require.NoError(t, err, "Default topic is rejected")
+	require.Equal(t, "", rep.topic, "Default topic mismatch")
+}
@@ -0,0 +1,34 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+)
+
+type SaveWebhookRepository interface {
+	SaveWebhook(_ context.Context, webhook model.Webhook) (int64, error)
+}
+
+type RegisterWebhookService struct {
+	rep SaveWebhookRepository
+	// allowPrivateNetworks skips the address check of the URL, for local environments only
+	allowPrivateNetworks bool
+}
+
+func NewRegisterWebhookService(rep SaveWebhookRepository, allowPrivateNetworks bool) *RegisterWebhookService {
+	return &RegisterWebhookService{
+		rep:                  rep,
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// RegisterWebhook saves the owner endpoint; registering the same URL again rotates its secret.
+// URLs pointing to internal addresses are rejected with model.ErrInvalidWebhookURL.
+func (s *RegisterWebhookService) RegisterWebhook(ctx context.Context, webhook model.Webhook) (int64, error) {
+	if !s.allowPrivateNetworks {
+		if err := model.ValidateWebhookURL(webhook.URL); err != nil {
+			return 0, err
+		}
+	}
+	return s.rep.SaveWebhook(ctx, webhook)
+}
@@ -0,0 +1,64 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"fmt"
+	"net/mail"
+)
+
+// minWebhookSecretLen matches the secret length required by RegisterWebhook.
+const minWebhookSecretLen = 16
+
+type SaveNotificationChannelRepository interface {
+	SaveNotificationChannel(_ context.Context, channel model.NotificationChannel) error
+}
+
+type SetNotificationChannelService struct {
+	rep SaveNotificationChannelRepository
+	// allowPrivateNetworks skips the address check of webhook URLs, for local environments only
+	allowPrivateNetworks bool
+}
+
+func NewSetNotificationChannelService(rep SaveNotificationChannelRepository, allowPrivateNetworks bool) *SetNotificationChannelService {
+	return &SetNotificationChannelService{
+		rep:                  rep,
+		allowPrivateNetworks: allowPrivateNetworks,
+	}
+}
+
+// SetNotificationChannel replaces the channel the notifier delivers the owner notifications to.
+// Email channels need a bare email address, webhook channels need a public URL and a secret;
+// invalid channels are rejected with model.ErrInvalidNotificationChannel.
+func (s *SetNotificationChannelService) SetNotificationChannel(ctx context.Context, channel model.NotificationChannel) (model.NotificationChannel, error) {
+	switch channel.Kind {
+	case model.ChannelLog:
+		channel.Address = ""
+		channel.Secret = ""
+	case model.ChannelEmail:
+		addr, err := mail.ParseAddress(channel.Address)
+		if err != nil || addr.Address != channel.Address {
+			return model.NotificationChannel{}, fmt.Errorf("%w: invalid email address", model.ErrInvalidNotificationChannel)
+		}
+		channel.Secret = ""
+	case model.ChannelWebhook:
+		if channel.Address == "" {
+			return model.NotificationChannel{}, fmt.Errorf("%w: webhook url is empty", model.ErrInvalidNotificationChannel)
+		}
+		if !s.allowPrivateNetworks {
+			if err := model.ValidateWebhookURL(channel.Address); err != nil {
+				return model.NotificationChannel{}, fmt.Errorf("%w: %w", model.ErrInvalidNotificationChannel, err)
+			}
+		}
+		if len(channel.Secret) < minWebhookSecretLen {
+			return model.NotificationChannel{}, fmt.Errorf("%w: webhook secret is shorter than %d bytes",
+				model.ErrInvalidNotificationChannel, minWebhookSecretLen)
+		}
+	default:
+		return model.NotificationChannel{}, fmt.Errorf("%w: unknown channel %s", model.ErrInvalidNotificationChannel, channel.Kind)
+	}
+	if err := s.rep.SaveNotificationChannel(ctx, channel); err != nil {
+		return model.NotificationChannel{}, err
+	}
+	return channel, nil
+}
@@ -0,0 +1,42 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type notificationChannelRepStub struct {
+	saved []model.NotificationChannel
+}
+
+func (r *notificationChannelRepStub) SaveNotificationChannel(_ context.Context, channel model.NotificationChannel) error {
+	r.saved = append(r.saved, channel)
+	return nil
+}
+
+func TestSetNotificationChannelValidates(t *testing.T) {
+	rep := &notificationChannelRepStub{}
+	service := NewSetNotificationChannelService(rep, false)
+
+	for _, channel := range []model.NotificationChannel{
+		{OwnerID: 1, Kind: model.ChannelEmail, Address: "owner@example.com\r\nBcc: other@example.com"},
+		{OwnerID: 1, Kind: model.ChannelEmail, Address: "Owner <owner@example.com>"},
+		{OwnerID: 1, Kind: model.ChannelWebhook, Address: "http://127.0.0.1/hook", Secret: "0123456789abcdef"},
+		{OwnerID: 1, Kind: model.ChannelWebhook, Address: "https://example.com/hook", Secret: "short"},
+		{OwnerID: 1, Kind: "sms"},
+	} {
+		_, err := service.SetNotificationChannel(context.Background(), channel)
+		require.ErrorIs(t, err, model.ErrInvalidNotificationChannel, "Invalid channel is accepted: %+v", channel)
+	}
+	require.Empty(t, rep.saved, "Invalid channel is saved")
+
+	saved, err := service.SetNotificationChannel(context.Background(), model.NotificationChannel{
+		OwnerID: 1, Kind: model.ChannelEmail, Address: "owner@example.com", Secret: "0123456789abcdef",
+	})
+	require.NoError(t, err, "Email channel is rejected")
+	require.Empty(t, saved.Secret, "Secret of email channel is saved")
+	require.Len(t, rep.saved, 1, "Channel is not saved")
+}
@@ -0,0 +1,35 @@
+package usecases
+
+import (
+	"context"
+	"example/comments/internal/model"
+	"time"
+)
+
+type SaveNotificationPreferencesRepository interface {
+	SaveNotificationPreferences(_ context.Context, prefs model.NotificationPreferences) (model.NotificationPreferences, error)
+}
+
+type SetNotificationPreferencesService struct {
+	rep SaveNotificationPreferencesRepository
+}
+
+func NewSetNotificationPreferencesService(rep SaveNotificationPreferencesRepository) *SetNotificationPreferencesService {
+	return &SetNotificationPreferencesService{
+		rep: rep,
+	}
+}
+
+// SetNotificationPreferences replaces the owner preferences. Choosing a digest mode schedules
+// the first digest at the start of the next period; notifications accumulated before the switch
+// are included into it. Quiet hours and digest periods follow the owner timezone.
+func (s *SetNotificationPreferencesService) SetNotificationPreferences(ctx context.Context, prefs model.NotificationPreferences) (model.NotificationPreferences, error) {
+	if err := model.ValidateTimezone(prefs.Timezone); err != nil {
+		return model.NotificationPreferences{}, err
+	}
+	if prefs.Timezone == "" {
+		prefs.Timezone = "UTC"
+	}
+	prefs.NextDigestAt = model.NextDigestAt(prefs.Mode, time.Now(), prefs.Location())
+	return s.rep.SaveNotificationPreferences(ctx, prefs)
+}
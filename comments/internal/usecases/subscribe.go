@@ -0,0 +1,51 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/model"
+)
+
+type SaveSubscriptionRepository interface {
+	SaveSubscription(_ context.Context, userID int64, productID int64) (bool, error)
+	DeleteSubscription(_ context.Context, userID int64, productID int64) (bool, error)
+}
+
+type SubscribeService struct {
+	rep            SaveSubscriptionRepository
+	userService    UserService
+	productService ProductsService
+}
+
+func NewSubscribeService(rep SaveSubscriptionRepository, products ProductsService, users UserService) *SubscribeService {
+	return &SubscribeService{
+		rep:            rep,
+		productService: products,
+		userService:    users,
+	}
+}
+
+// Subscribe checks the user and the product like CreateComment does and returns false
+// if the user is already subscribed.
+func (s *SubscribeService) Subscribe(ctx context.Context, userID int64, productID int64) (bool, error) {
+	isCorrectUserID, err := s.userService.CheckUserID(ctx, userID)
+	if err != nil {
+		return false, errors.Join(model.ErrUserServiceUnavailable, err)
+	}
+	if !isCorrectUserID {
+		return false, model.ErrIncorrectUserID
+	}
+	productOwnerID, err := s.productService.GetProductOwner(ctx, productID)
+	if err != nil {
+		return false, errors.Join(model.ErrProductServiceUnavailable, err)
+	}
+	if productOwnerID == 0 {
+		return false, model.ErrProductOwnerNotFound
+	}
+	return s.rep.SaveSubscription(ctx, userID, productID)
+}
+
+// Unsubscribe returns false if the user was not subscribed.
+func (s *SubscribeService) Unsubscribe(ctx context.Context, userID int64, productID int64) (bool, error) {
+	return s.rep.DeleteSubscription(ctx, userID, productID)
+}
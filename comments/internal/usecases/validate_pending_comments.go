@@ -0,0 +1,116 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/logger"
+	"example/comments/internal/metrics"
+	"example/comments/internal/model"
+	"time"
+)
+
+type PendingCommentsRepository interface {
+	GetPendingComments(_ context.Context, limit int) ([]model.Comment, error)
+	PublishPendingComment(_ context.Context, comment model.Comment) (bool, error)
+	RejectPendingComment(_ context.Context, comment model.Comment, reason string) (bool, error)
+	PostponeCommentValidation(_ context.Context, commentID int64, validateAt time.Time) error
+}
+
+// PendingValidator checks the comments accepted in degraded mode once the services are back.
+// A valid comment is published with the notifications CreateComment would send, an invalid
+// one is rejected and its author is notified. While a service is still unavailable the next
+// attempt is postponed, so pending comments are never lost.
+type PendingValidator struct {
+	rep    PendingCommentsRepository
+	create *CreateCommentService
+	conf   DegradedConfig
+}
+
+func NewPendingValidator(rep PendingCommentsRepository, create *CreateCommentService) *PendingValidator {
+	return &PendingValidator{
+		rep:    rep,
+		create: create,
+		conf:   create.degraded,
+	}
+}
+
+func (v *PendingValidator) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(v.conf.Timer)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Infow(ctx, "pending validator context closed")
+				return
+			case <-ticker.C:
+				v.ValidatePending(ctx)
+			}
+		}
+	}()
+}
+
+// ValidatePending validates one batch of the pending comments that are due.
+func (v *PendingValidator) ValidatePending(ctx context.Context) {
+	comments, err := v.rep.GetPendingComments(ctx, v.conf.BatchSize)
+	if err != nil {
+		logger.Warnw(ctx, "can not get pending comments", "error", err.Error())
+		return
+	}
+	for _, comment := range comments {
+		if ctx.Err() != nil {
+			return
+		}
+		if err = v.validate(ctx, comment); err != nil {
+			logger.Warnw(ctx, "pending comment validation failed", "comment_id", comment.ID, "error", err.Error())
+		}
+	}
+}
+
+func (v *PendingValidator) validate(ctx context.Context, comment model.Comment) error {
+	productOwnerID, mentioned, err := v.create.validate(ctx, comment)
+	if err == nil {
+		comment.ProductOwnerID = productOwnerID
+		comment.Recipients, err = v.create.recipients(ctx, comment, mentioned)
+	}
+	switch {
+	case err == nil:
+		return v.publish(ctx, comment)
+	case errors.Is(err, model.ErrIncorrectUserID):
+		return v.reject(ctx, comment, model.RejectIncorrectUser)
+	case errors.Is(err, model.ErrProductOwnerNotFound):
+		return v.reject(ctx, comment, model.RejectProductNotFound)
+	case errors.Is(err, model.ErrParentCommentNotFound):
+		return v.reject(ctx, comment, model.RejectParentNotFound)
+	case unavailable(err):
+		validateAt := time.Now().Add(v.conf.backoff(comment.ValidationAttempts + 1))
+		if err = v.rep.PostponeCommentValidation(ctx, comment.ID, validateAt); err != nil {
+			return err
+		}
+		metrics.IncDegradedComments(metrics.DegradedPostponed)
+	default:
+		// the comment stays due and is validated again on the next tick
+		return err
+	}
+	return nil
+}
+
+func (v *PendingValidator) publish(ctx context.Context, comment model.Comment) error {
+	published, err := v.rep.PublishPendingComment(ctx, comment)
+	if err != nil || !published {
+		return err
+	}
+	metrics.IncDegradedComments(metrics.DegradedPublished)
+	logger.Infow(ctx, "pending comment published", "comment_id", comment.ID)
+	return nil
+}
+
+func (v *PendingValidator) reject(ctx context.Context, comment model.Comment, reason string) error {
+	rejected, err := v.rep.RejectPendingComment(ctx, comment, reason)
+	if err != nil || !rejected {
+		return err
+	}
+	metrics.IncDegradedComments(metrics.DegradedRejected)
+	logger.Infow(ctx, "pending comment rejected", "comment_id", comment.ID, "reason", reason)
+	return nil
+}
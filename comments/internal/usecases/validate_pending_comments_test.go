@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"example/comments/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type pendingRepStub struct {
+	saveRepStub
+	comments  []model.Comment
+	published []model.Comment
+	rejected  map[int64]string
+	postponed map[int64]time.Time
+}
+
+func (r *pendingRepStub) GetPendingComments(_ context.Context, _ int) ([]model.Comment, error) {
+	return r.comments, nil
+}
+
+func (r *pendingRepStub) PublishPendingComment(_ context.Context, comment model.Comment) (bool, error) {
+	r.published = append(r.published, comment)
+	return true, nil
+}
+
+func (r *pendingRepStub) RejectPendingComment(_ context.Context, comment model.Comment, reason string) (bool, error) {
+	r.rejected[comment.ID] = reason
+	return true, nil
+}
+
+func (r *pendingRepStub) PostponeCommentValidation(_ context.Context, commentID int64, validateAt time.Time) error {
+	r.postponed[commentID] = validateAt
+	return nil
+}
+
+func newPendingRepStub(comments ...model.Comment) *pendingRepStub {
+	return &pendingRepStub{
+		comments:  comments,
+		rejected:  make(map[int64]string),
+		postponed: make(map[int64]time.Time),
+	}
+}
+
+func TestPendingValidatorPublishes(t *testing.T) {
+	rep := newPendingRepStub(model.Comment{ID: 1, UserID: 10, ProductID: 2, Text: "text"})
+	create := NewCreateCommentService(rep, &productsFake{ownerID: 7}, &usersFake{correct: true}, DegradedConfig{Enabled: true})
+
+	NewPendingValidator(rep, create).ValidatePending(context.Background())
+	require.Equal(t, 1, len(rep.published), "Comment is not published")
+	require.Equal(t, int64(7), rep.published[0].ProductOwnerID, "Product owner mismatch")
+	require.Equal(t, []model.Recipient{{UserID: 7, Reason: model.ReasonNewComment}}, rep.published[0].Recipients, "Recipients mismatch")
+}
+
+func TestPendingValidatorRejects(t *testing.T) {
+	rep := newPendingRepStub(model.Comment{ID: 1, UserID: 10, ProductID: 2, Text: "text"})
+	create := NewCreateCommentService(rep, &productsFake{ownerID: 7}, &usersFake{correct: false}, DegradedConfig{Enabled: true})
+
+	NewPendingValidator(rep, create).ValidatePending(context.Background())
+	require.Equal(t, model.RejectIncorrectUser, rep.rejected[1], "Reject reason mismatch")
+	require.Empty(t, rep.published, "Invalid comment is published")
+
+	rep = newPendingRepStub(model.Comment{ID: 2, UserID: 10, ProductID: 2, ParentID: 9, Text: "text"})
+	create = NewCreateCommentService(rep, &productsFake{ownerID: 7}, &usersFake{correct: true}, DegradedConfig{Enabled: true})
+
+	NewPendingValidator(rep, create).ValidatePending(context.Background())
+	require.Equal(t, model.RejectParentNotFound, rep.rejected[2], "Reject reason mismatch")
+}
+
+func TestPendingValidatorPostpones(t *testing.T) {
+	rep := newPendingRepStub(model.Comment{ID: 1, UserID: 10, ProductID: 2, Text: "text", ValidationAttempts: 1})
+	degraded := DegradedConfig{Enabled: true, Backoff: time.Minute, MaxBackoff: time.Hour}
+	create := NewCreateCommentService(rep, &productsFake{err: errors.New("unavailable")}, &usersFake{correct: true}, degraded)
+
+	before := time.Now()
+	NewPendingValidator(rep, create).ValidatePending(context.Background())
+	require.Empty(t, rep.published, "Comment is published")
+	require.Empty(t, rep.rejected, "Comment is rejected")
+	require.WithinDuration(t, before.Add(4*time.Minute), rep.postponed[1], time.Second, "Next attempt mismatch")
+}
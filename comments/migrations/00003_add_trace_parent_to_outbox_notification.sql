@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE outbox_notification
+    ADD COLUMN trace_parent text;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox_notification
+    DROP COLUMN trace_parent;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE processed_events
+(
+    consumer     text      not null,
+    event_id     text      not null,
+    processed_at timestamp not null DEFAULT now(),
+    PRIMARY KEY (consumer, event_id)
+);
+
+CREATE TABLE notification_channels
+(
+    owner_id bigint PRIMARY KEY,
+    channel  text not null DEFAULT 'log',
+    address  text not null DEFAULT '',
+    locale   text not null DEFAULT 'ru'
+);
+ALTER TABLE notification_channels
+    ADD CONSTRAINT owner_id_positive CHECK ( owner_id > 0 );
+ALTER TABLE notification_channels
+    ADD CONSTRAINT check_channel CHECK ( channel IN ('log', 'email', 'webhook'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE notification_channels;
+DROP TABLE processed_events;
+-- +goose StatementEnd
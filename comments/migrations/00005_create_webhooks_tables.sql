@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhooks
+(
+    id         bigserial PRIMARY KEY,
+    owner_id   bigint    not null,
+    url        text      not null,
+    secret     text      not null,
+    created_at timestamp not null DEFAULT now()
+);
+CREATE UNIQUE INDEX webhooks_owner_id_url_idx ON webhooks (owner_id, url);
+ALTER TABLE webhooks
+    ADD CONSTRAINT owner_id_positive CHECK ( owner_id > 0 );
+
+CREATE TABLE webhook_deliveries
+(
+    id              bigserial PRIMARY KEY,
+    webhook_id      bigint    not null REFERENCES webhooks (id) ON DELETE CASCADE,
+    notification_id bigint    not null,
+    attempt         integer   not null,
+    status_code     integer   not null DEFAULT 0,
+    error           text      not null DEFAULT '',
+    success         boolean   not null DEFAULT false,
+    ts              timestamp not null DEFAULT now()
+);
+CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id, ts);
+
+ALTER TABLE outbox_notification
+    ADD COLUMN webhook_status text not null DEFAULT 'new';
+UPDATE outbox_notification
+SET webhook_status = 'done';
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_webhook_status CHECK ( webhook_status IN ('new', 'done'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE outbox_notification
+    DROP COLUMN webhook_status;
+DROP TABLE webhook_deliveries;
+DROP TABLE webhooks;
+-- +goose StatementEnd
@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notification_preferences
+(
+    owner_id          bigint PRIMARY KEY,
+    mode              text      not null DEFAULT 'immediate',
+    muted_products    bigint[]  not null DEFAULT '{}',
+    quiet_hours_start smallint,
+    quiet_hours_end   smallint,
+    next_digest_at    timestamp,
+    updated_at        timestamp not null DEFAULT now()
+);
+ALTER TABLE notification_preferences
+    ADD CONSTRAINT owner_id_positive CHECK ( owner_id > 0 );
+ALTER TABLE notification_preferences
+    ADD CONSTRAINT check_mode CHECK ( mode IN ('immediate', 'hourly', 'daily'));
+ALTER TABLE notification_preferences
+    ADD CONSTRAINT check_quiet_hours CHECK (
+        (quiet_hours_start IS NULL AND quiet_hours_end IS NULL) OR
+        (quiet_hours_start BETWEEN 0 AND 23 AND quiet_hours_end BETWEEN 0 AND 23));
+
+ALTER TABLE outbox_notification
+    ADD COLUMN product_id bigint;
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_status;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send', 'muted'));
+CREATE INDEX outbox_notification_owner_id_status_idx ON outbox_notification (owner_id, status);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX outbox_notification_owner_id_status_idx;
+UPDATE outbox_notification
+SET status = 'send'
+WHERE status = 'muted';
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_status;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send'));
+ALTER TABLE outbox_notification
+    DROP COLUMN product_id;
+DROP TABLE notification_preferences;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE comments
+    ADD COLUMN parent_id bigint REFERENCES comments (id);
+CREATE INDEX comments_parent_id_idx ON comments (parent_id);
+
+ALTER TABLE outbox_notification
+    RENAME COLUMN owner_id TO recipient_id;
+ALTER TABLE outbox_notification
+    ADD COLUMN reason text not null DEFAULT 'new_comment';
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_reason CHECK ( reason IN ('new_comment', 'reply', 'owner_answer', 'mention'));
+CREATE UNIQUE INDEX outbox_notification_comment_id_recipient_id_idx ON outbox_notification (comment_id, recipient_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX outbox_notification_comment_id_recipient_id_idx;
+DELETE
+FROM outbox_notification
+WHERE reason <> 'new_comment';
+ALTER TABLE outbox_notification
+    DROP COLUMN reason;
+ALTER TABLE outbox_notification
+    RENAME COLUMN recipient_id TO owner_id;
+
+DROP INDEX comments_parent_id_idx;
+ALTER TABLE comments
+    DROP COLUMN parent_id;
+-- +goose StatementEnd
@@ -0,0 +1,45 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE product_subscriptions
+(
+    user_id    bigint    not null,
+    product_id bigint    not null,
+    created_at timestamp not null DEFAULT now(),
+    PRIMARY KEY (user_id, product_id)
+);
+ALTER TABLE product_subscriptions
+    ADD CONSTRAINT user_id_positive CHECK ( user_id > 0 );
+ALTER TABLE product_subscriptions
+    ADD CONSTRAINT product_id_positive CHECK ( product_id > 0 );
+CREATE INDEX product_subscriptions_product_id_user_id_idx ON product_subscriptions (product_id, user_id);
+
+CREATE TABLE subscription_fanout
+(
+    comment_id   bigint PRIMARY KEY REFERENCES comments (id),
+    trace_parent text,
+    last_user_id bigint not null DEFAULT 0,
+    status       text   not null DEFAULT 'new'
+);
+ALTER TABLE subscription_fanout
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'done'));
+CREATE INDEX subscription_fanout_status_idx ON subscription_fanout (status);
+
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_reason;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_reason CHECK ( reason IN ('new_comment', 'reply', 'owner_answer', 'mention', 'subscription'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE
+FROM outbox_notification
+WHERE reason = 'subscription';
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_reason;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_reason CHECK ( reason IN ('new_comment', 'reply', 'owner_answer', 'mention'));
+
+DROP TABLE subscription_fanout;
+DROP TABLE product_subscriptions;
+-- +goose StatementEnd
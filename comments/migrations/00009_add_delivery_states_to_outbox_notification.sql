@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE outbox_notification
+    ADD COLUMN sent_at timestamp;
+ALTER TABLE outbox_notification
+    ADD COLUMN delivered_at timestamp;
+ALTER TABLE outbox_notification
+    ADD COLUMN read_at timestamp;
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_status;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send', 'muted', 'delivered', 'read'));
+CREATE INDEX outbox_notification_comment_id_idx ON outbox_notification (comment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX outbox_notification_comment_id_idx;
+UPDATE outbox_notification
+SET status = 'send'
+WHERE status IN ('delivered', 'read');
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_status;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send', 'muted'));
+ALTER TABLE outbox_notification
+    DROP COLUMN read_at;
+ALTER TABLE outbox_notification
+    DROP COLUMN delivered_at;
+ALTER TABLE outbox_notification
+    DROP COLUMN sent_at;
+-- +goose StatementEnd
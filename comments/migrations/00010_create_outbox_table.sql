@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE outbox
+(
+    id            bigserial PRIMARY KEY,
+    topic         text      not null,
+    key           text      not null DEFAULT '',
+    payload       bytea     not null,
+    headers       jsonb     not null DEFAULT '{}',
+    created_at    timestamp not null DEFAULT now(),
+    status        text      not null DEFAULT 'new',
+    claimed_until timestamp,
+    sent_at       timestamp
+);
+ALTER TABLE outbox
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send'));
+CREATE INDEX outbox_new_idx ON outbox (id) WHERE status = 'new';
+CREATE INDEX outbox_new_key_idx ON outbox (key, id) WHERE status = 'new';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE outbox;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE comments
+    ADD COLUMN author_banned boolean not null DEFAULT false;
+CREATE INDEX comments_user_id_idx ON comments (user_id);
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_status;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send', 'muted', 'delivered', 'read', 'canceled'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE outbox_notification
+SET status = 'muted'
+WHERE status = 'canceled';
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_status;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'send', 'muted', 'delivered', 'read'));
+DROP INDEX comments_user_id_idx;
+ALTER TABLE comments
+    DROP COLUMN author_banned;
+-- +goose StatementEnd
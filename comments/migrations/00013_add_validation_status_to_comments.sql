@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE comments
+    ADD COLUMN status text not null DEFAULT 'published';
+ALTER TABLE comments
+    ADD CONSTRAINT check_status CHECK ( status IN ('published', 'pending_validation', 'rejected'));
+ALTER TABLE comments
+    ADD COLUMN validation_attempts integer not null DEFAULT 0;
+ALTER TABLE comments
+    ADD COLUMN validate_at timestamp;
+ALTER TABLE comments
+    ADD COLUMN reject_reason text;
+CREATE INDEX comments_pending_validation_idx ON comments (validate_at) WHERE status = 'pending_validation';
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_reason;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_reason CHECK ( reason IN ('new_comment', 'reply', 'owner_answer', 'mention', 'subscription',
+                                                   'comment_rejected'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE
+FROM outbox_notification
+WHERE reason = 'comment_rejected';
+ALTER TABLE outbox_notification
+    DROP CONSTRAINT check_reason;
+ALTER TABLE outbox_notification
+    ADD CONSTRAINT check_reason CHECK ( reason IN ('new_comment', 'reply', 'owner_answer', 'mention', 'subscription'));
+DROP INDEX comments_pending_validation_idx;
+ALTER TABLE comments
+    DROP COLUMN reject_reason;
+ALTER TABLE comments
+    DROP COLUMN validate_at;
+ALTER TABLE comments
+    DROP COLUMN validation_attempts;
+ALTER TABLE comments
+    DROP COLUMN status;
+-- +goose StatementEnd
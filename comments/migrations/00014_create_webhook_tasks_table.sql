@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_tasks
+(
+    id              bigserial PRIMARY KEY,
+    webhook_id      bigint    not null REFERENCES webhooks (id) ON DELETE CASCADE,
+    notification_id bigint    not null,
+    event           text      not null,
+    payload         jsonb     not null,
+    trace_parent    text,
+    attempt         integer   not null DEFAULT 0,
+    status          text      not null DEFAULT 'new',
+    next_attempt_at timestamp not null DEFAULT now(),
+    created_at      timestamp not null DEFAULT now()
+);
+ALTER TABLE webhook_tasks
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'done', 'failed'));
+ALTER TABLE webhook_tasks
+    ADD CONSTRAINT webhook_tasks_notification_key UNIQUE (webhook_id, notification_id, event);
+CREATE INDEX webhook_tasks_next_attempt_at_idx ON webhook_tasks (next_attempt_at) WHERE status = 'new';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_tasks;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notification_preferences
+    ADD COLUMN timezone text not null DEFAULT 'UTC';
+
+-- in_quiet_hours reports whether the moment falls into the quiet hours of the owner timezone,
+-- the interval [quiet_start, quiet_end) may wrap over midnight
+CREATE FUNCTION in_quiet_hours(quiet_start smallint, quiet_end smallint, tz text, at timestamptz)
+    RETURNS boolean
+    LANGUAGE sql
+    STABLE AS
+$$
+SELECT CASE
+           WHEN quiet_start IS NULL OR quiet_end IS NULL THEN false
+           WHEN quiet_start <= quiet_end THEN h >= quiet_start AND h < quiet_end
+           ELSE h >= quiet_start OR h < quiet_end
+           END
+FROM (SELECT EXTRACT(HOUR FROM at AT TIME ZONE tz)::smallint AS h) l
+$$;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION in_quiet_hours;
+ALTER TABLE notification_preferences
+    DROP COLUMN timezone;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE processed_events
+    ADD COLUMN status text not null DEFAULT 'done';
+ALTER TABLE processed_events
+    ADD CONSTRAINT check_status CHECK ( status IN ('processing', 'done'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE
+FROM processed_events
+WHERE status = 'processing';
+ALTER TABLE processed_events
+    DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE notification_channels
+    ADD COLUMN secret text not null DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE notification_channels
+    DROP COLUMN secret;
+-- +goose StatementEnd
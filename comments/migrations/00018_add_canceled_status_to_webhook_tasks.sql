@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE webhook_tasks
+    DROP CONSTRAINT check_status;
+ALTER TABLE webhook_tasks
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'done', 'failed', 'canceled'));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE webhook_tasks
+SET status = 'failed'
+WHERE status = 'canceled';
+ALTER TABLE webhook_tasks
+    DROP CONSTRAINT check_status;
+ALTER TABLE webhook_tasks
+    ADD CONSTRAINT check_status CHECK ( status IN ('new', 'done', 'failed'));
+-- +goose StatementEnd
@@ -90,6 +90,17 @@ func (m *CreateCommentRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetParentID() < 0 {
+		err := CreateCommentRequestValidationError{
+			field:  "ParentID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return CreateCommentRequestMultiError(errors)
 	}
@@ -194,6 +205,8 @@ func (m *CreateCommentResponse) validate(all bool) error {
 
 	// no validation rules for CommentID
 
+	// no validation rules for Status
+
 	if len(errors) > 0 {
 		return CreateCommentResponseMultiError(errors)
 	}
@@ -357,6 +370,37 @@ func (m *Comment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ParentID
+
+	if all {
+		switch v := interface{}(m.GetAuthor()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CommentValidationError{
+					field:  "Author",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAuthor()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CommentValidationError{
+				field:  "Author",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CommentMultiError(errors)
 	}
@@ -434,6 +478,108 @@ var _ interface {
 	ErrorName() string
 } = CommentValidationError{}
 
+// Validate checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Author) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Author with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AuthorMultiError, or nil if none found.
+func (m *Author) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Author) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DisplayName
+
+	// no validation rules for AvatarURL
+
+	if len(errors) > 0 {
+		return AuthorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthorMultiError is an error wrapping multiple validation errors returned by
+// Author.ValidateAll() if the designated constraints aren't met.
+type AuthorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthorMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthorMultiError) AllErrors() []error { return m }
+
+// AuthorValidationError is the validation error returned by Author.Validate if
+// the designated constraints aren't met.
+type AuthorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthorValidationError) ErrorName() string { return "AuthorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthorValidationError{}
+
 // Validate checks the field values on GetCommentsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -467,6 +613,17 @@ func (m *GetCommentsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := _GetCommentsRequest_Expand_InLookup[m.GetExpand()]; !ok {
+		err := GetCommentsRequestValidationError{
+			field:  "Expand",
+			reason: "value must be in list [ author]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetCommentsRequestMultiError(errors)
 	}
@@ -547,6 +704,11 @@ var _ interface {
 	ErrorName() string
 } = GetCommentsRequestValidationError{}
 
+var _GetCommentsRequest_Expand_InLookup = map[string]struct{}{
+	"":       {},
+	"author": {},
+}
+
 // Validate checks the field values on GetCommentsResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -605,6 +767,8 @@ func (m *GetCommentsResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for AuthorsUnavailable
+
 	if len(errors) > 0 {
 		return GetCommentsResponseMultiError(errors)
 	}
@@ -684,3 +848,4677 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetCommentsResponseValidationError{}
+
+// Validate checks the field values on RegisterWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterWebhookRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterWebhookRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterWebhookRequestMultiError, or nil if none found.
+func (m *RegisterWebhookRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterWebhookRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOwnerID() <= 0 {
+		err := RegisterWebhookRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUrl()) > 2048 {
+		err := RegisterWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = RegisterWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := RegisterWebhookRequestValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetSecret()); l < 16 || l > 256 {
+		err := RegisterWebhookRequestValidationError{
+			field:  "Secret",
+			reason: "value length must be between 16 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RegisterWebhookRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterWebhookRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterWebhookRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterWebhookRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterWebhookRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterWebhookRequestMultiError) AllErrors() []error { return m }
+
+// RegisterWebhookRequestValidationError is the validation error returned by
+// RegisterWebhookRequest.Validate if the designated constraints aren't met.
+type RegisterWebhookRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterWebhookRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterWebhookRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterWebhookRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterWebhookRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterWebhookRequestValidationError) ErrorName() string {
+	return "RegisterWebhookRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterWebhookRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterWebhookRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterWebhookRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterWebhookRequestValidationError{}
+
+// Validate checks the field values on RegisterWebhookResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterWebhookResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterWebhookResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterWebhookResponseMultiError, or nil if none found.
+func (m *RegisterWebhookResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterWebhookResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WebhookID
+
+	if len(errors) > 0 {
+		return RegisterWebhookResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterWebhookResponseMultiError is an error wrapping multiple validation
+// errors returned by RegisterWebhookResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterWebhookResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterWebhookResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterWebhookResponseMultiError) AllErrors() []error { return m }
+
+// RegisterWebhookResponseValidationError is the validation error returned by
+// RegisterWebhookResponse.Validate if the designated constraints aren't met.
+type RegisterWebhookResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterWebhookResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterWebhookResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterWebhookResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterWebhookResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterWebhookResponseValidationError) ErrorName() string {
+	return "RegisterWebhookResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterWebhookResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterWebhookResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterWebhookResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterWebhookResponseValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesRequestMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOwnerID() <= 0 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 100 {
+		err := ListWebhookDeliveriesRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesRequestMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListWebhookDeliveriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesRequestMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesRequestValidationError is the validation error returned
+// by ListWebhookDeliveriesRequest.Validate if the designated constraints
+// aren't met.
+type ListWebhookDeliveriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesRequestValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesRequestValidationError{}
+
+// Validate checks the field values on WebhookDelivery with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WebhookDelivery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookDelivery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WebhookDeliveryMultiError, or nil if none found.
+func (m *WebhookDelivery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookDelivery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ID
+
+	// no validation rules for WebhookID
+
+	// no validation rules for Url
+
+	// no validation rules for NotificationID
+
+	// no validation rules for Attempt
+
+	// no validation rules for StatusCode
+
+	// no validation rules for Error
+
+	// no validation rules for Success
+
+	if all {
+		switch v := interface{}(m.GetTs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "Ts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookDeliveryValidationError{
+					field:  "Ts",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookDeliveryValidationError{
+				field:  "Ts",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return WebhookDeliveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookDeliveryMultiError is an error wrapping multiple validation errors
+// returned by WebhookDelivery.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookDeliveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookDeliveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookDeliveryMultiError) AllErrors() []error { return m }
+
+// WebhookDeliveryValidationError is the validation error returned by
+// WebhookDelivery.Validate if the designated constraints aren't met.
+type WebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookDeliveryValidationError) ErrorName() string { return "WebhookDeliveryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookDeliveryValidationError{}
+
+// Validate checks the field values on ListWebhookDeliveriesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListWebhookDeliveriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListWebhookDeliveriesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListWebhookDeliveriesResponseMultiError, or nil if none found.
+func (m *ListWebhookDeliveriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListWebhookDeliveriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerID
+
+	for idx, item := range m.GetDeliveries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListWebhookDeliveriesResponseValidationError{
+						field:  fmt.Sprintf("Deliveries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListWebhookDeliveriesResponseValidationError{
+					field:  fmt.Sprintf("Deliveries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListWebhookDeliveriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListWebhookDeliveriesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListWebhookDeliveriesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type ListWebhookDeliveriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListWebhookDeliveriesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListWebhookDeliveriesResponseMultiError) AllErrors() []error { return m }
+
+// ListWebhookDeliveriesResponseValidationError is the validation error
+// returned by ListWebhookDeliveriesResponse.Validate if the designated
+// constraints aren't met.
+type ListWebhookDeliveriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListWebhookDeliveriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListWebhookDeliveriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListWebhookDeliveriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListWebhookDeliveriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListWebhookDeliveriesResponseValidationError) ErrorName() string {
+	return "ListWebhookDeliveriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListWebhookDeliveriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListWebhookDeliveriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListWebhookDeliveriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListWebhookDeliveriesResponseValidationError{}
+
+// Validate checks the field values on QuietHours with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QuietHours) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuietHours with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QuietHoursMultiError, or
+// nil if none found.
+func (m *QuietHours) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuietHours) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetStartHour(); val < 0 || val > 23 {
+		err := QuietHoursValidationError{
+			field:  "StartHour",
+			reason: "value must be inside range [0, 23]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetEndHour(); val < 0 || val > 23 {
+		err := QuietHoursValidationError{
+			field:  "EndHour",
+			reason: "value must be inside range [0, 23]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return QuietHoursMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuietHoursMultiError is an error wrapping multiple validation errors
+// returned by QuietHours.ValidateAll() if the designated constraints aren't met.
+type QuietHoursMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuietHoursMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuietHoursMultiError) AllErrors() []error { return m }
+
+// QuietHoursValidationError is the validation error returned by
+// QuietHours.Validate if the designated constraints aren't met.
+type QuietHoursValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuietHoursValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuietHoursValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuietHoursValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuietHoursValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuietHoursValidationError) ErrorName() string { return "QuietHoursValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QuietHoursValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuietHours.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuietHoursValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuietHoursValidationError{}
+
+// Validate checks the field values on NotificationPreferences with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationPreferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationPreferences with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationPreferencesMultiError, or nil if none found.
+func (m *NotificationPreferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationPreferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerID
+
+	// no validation rules for Mode
+
+	if all {
+		switch v := interface{}(m.GetQuietHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationPreferencesValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationPreferencesValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationPreferencesValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Timezone
+
+	if len(errors) > 0 {
+		return NotificationPreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationPreferencesMultiError is an error wrapping multiple validation
+// errors returned by NotificationPreferences.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationPreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationPreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationPreferencesMultiError) AllErrors() []error { return m }
+
+// NotificationPreferencesValidationError is the validation error returned by
+// NotificationPreferences.Validate if the designated constraints aren't met.
+type NotificationPreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationPreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationPreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationPreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationPreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationPreferencesValidationError) ErrorName() string {
+	return "NotificationPreferencesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationPreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationPreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationPreferencesValidationError{}
+
+// Validate checks the field values on SetNotificationPreferencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetNotificationPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetNotificationPreferencesRequestMultiError, or nil if none found.
+func (m *SetNotificationPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetNotificationPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOwnerID() <= 0 {
+		err := SetNotificationPreferencesRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetNotificationPreferencesRequest_Mode_NotInLookup[m.GetMode()]; ok {
+		err := SetNotificationPreferencesRequestValidationError{
+			field:  "Mode",
+			reason: "value must not be in list [NOTIFICATION_MODE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := NotificationMode_name[int32(m.GetMode())]; !ok {
+		err := SetNotificationPreferencesRequestValidationError{
+			field:  "Mode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetMutedProductIDs()) > 1000 {
+		err := SetNotificationPreferencesRequestValidationError{
+			field:  "MutedProductIDs",
+			reason: "value must contain no more than 1000 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_SetNotificationPreferencesRequest_MutedProductIDs_Unique := make(map[int64]struct{}, len(m.GetMutedProductIDs()))
+
+	for idx, item := range m.GetMutedProductIDs() {
+		_, _ = idx, item
+
+		if _, exists := _SetNotificationPreferencesRequest_MutedProductIDs_Unique[item]; exists {
+			err := SetNotificationPreferencesRequestValidationError{
+				field:  fmt.Sprintf("MutedProductIDs[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_SetNotificationPreferencesRequest_MutedProductIDs_Unique[item] = struct{}{}
+		}
+
+		if item <= 0 {
+			err := SetNotificationPreferencesRequestValidationError{
+				field:  fmt.Sprintf("MutedProductIDs[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetQuietHours()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetNotificationPreferencesRequestValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetNotificationPreferencesRequestValidationError{
+					field:  "QuietHours",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuietHours()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetNotificationPreferencesRequestValidationError{
+				field:  "QuietHours",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetTimezone()) > 64 {
+		err := SetNotificationPreferencesRequestValidationError{
+			field:  "Timezone",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetNotificationPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetNotificationPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetNotificationPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetNotificationPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetNotificationPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetNotificationPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// SetNotificationPreferencesRequestValidationError is the validation error
+// returned by SetNotificationPreferencesRequest.Validate if the designated
+// constraints aren't met.
+type SetNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "SetNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNotificationPreferencesRequestValidationError{}
+
+var _SetNotificationPreferencesRequest_Mode_NotInLookup = map[NotificationMode]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetNotificationPreferencesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetNotificationPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationPreferencesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetNotificationPreferencesRequestMultiError, or nil if none found.
+func (m *GetNotificationPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOwnerID() <= 0 {
+		err := GetNotificationPreferencesRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetNotificationPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationPreferencesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetNotificationPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetNotificationPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetNotificationPreferencesRequestValidationError is the validation error
+// returned by GetNotificationPreferencesRequest.Validate if the designated
+// constraints aren't met.
+type GetNotificationPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationPreferencesRequestValidationError) ErrorName() string {
+	return "GetNotificationPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationPreferencesRequestValidationError{}
+
+// Validate checks the field values on NotificationChannel with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationChannel) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationChannel with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationChannelMultiError, or nil if none found.
+func (m *NotificationChannel) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationChannel) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OwnerID
+
+	// no validation rules for Channel
+
+	// no validation rules for Address
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return NotificationChannelMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationChannelMultiError is an error wrapping multiple validation
+// errors returned by NotificationChannel.ValidateAll() if the designated
+// constraints aren't met.
+type NotificationChannelMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationChannelMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationChannelMultiError) AllErrors() []error { return m }
+
+// NotificationChannelValidationError is the validation error returned by
+// NotificationChannel.Validate if the designated constraints aren't met.
+type NotificationChannelValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationChannelValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationChannelValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationChannelValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationChannelValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationChannelValidationError) ErrorName() string {
+	return "NotificationChannelValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationChannelValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationChannel.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationChannelValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationChannelValidationError{}
+
+// Validate checks the field values on SetNotificationChannelRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetNotificationChannelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetNotificationChannelRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// SetNotificationChannelRequestMultiError, or nil if none found.
+func (m *SetNotificationChannelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetNotificationChannelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOwnerID() <= 0 {
+		err := SetNotificationChannelRequestValidationError{
+			field:  "OwnerID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetNotificationChannelRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := SetNotificationChannelRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [log email webhook]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 2048 {
+		err := SetNotificationChannelRequestValidationError{
+			field:  "Address",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _SetNotificationChannelRequest_Locale_InLookup[m.GetLocale()]; !ok {
+		err := SetNotificationChannelRequestValidationError{
+			field:  "Locale",
+			reason: "value must be in list [ ru en]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetSecret()) > 256 {
+		err := SetNotificationChannelRequestValidationError{
+			field:  "Secret",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetNotificationChannelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetNotificationChannelRequestMultiError is an error wrapping multiple
+// validation errors returned by SetNotificationChannelRequest.ValidateAll()
+// if the designated constraints aren't met.
+type SetNotificationChannelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetNotificationChannelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetNotificationChannelRequestMultiError) AllErrors() []error { return m }
+
+// SetNotificationChannelRequestValidationError is the validation error
+// returned by SetNotificationChannelRequest.Validate if the designated
+// constraints aren't met.
+type SetNotificationChannelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetNotificationChannelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetNotificationChannelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetNotificationChannelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetNotificationChannelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetNotificationChannelRequestValidationError) ErrorName() string {
+	return "SetNotificationChannelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetNotificationChannelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetNotificationChannelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetNotificationChannelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetNotificationChannelRequestValidationError{}
+
+var _SetNotificationChannelRequest_Channel_InLookup = map[string]struct{}{
+	"log":     {},
+	"email":   {},
+	"webhook": {},
+}
+
+var _SetNotificationChannelRequest_Locale_InLookup = map[string]struct{}{
+	"":   {},
+	"ru": {},
+	"en": {},
+}
+
+// Validate checks the field values on GetNotificationStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNotificationStatusRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationStatusRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetNotificationStatusRequestMultiError, or nil if none found.
+func (m *GetNotificationStatusRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationStatusRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCommentID() <= 0 {
+		err := GetNotificationStatusRequestValidationError{
+			field:  "CommentID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetNotificationStatusRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationStatusRequestMultiError is an error wrapping multiple
+// validation errors returned by GetNotificationStatusRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetNotificationStatusRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationStatusRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationStatusRequestMultiError) AllErrors() []error { return m }
+
+// GetNotificationStatusRequestValidationError is the validation error returned
+// by GetNotificationStatusRequest.Validate if the designated constraints
+// aren't met.
+type GetNotificationStatusRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationStatusRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationStatusRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationStatusRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationStatusRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationStatusRequestValidationError) ErrorName() string {
+	return "GetNotificationStatusRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationStatusRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationStatusRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationStatusRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationStatusRequestValidationError{}
+
+// Validate checks the field values on NotificationStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NotificationStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NotificationStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NotificationStatusMultiError, or nil if none found.
+func (m *NotificationStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NotificationStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationID
+
+	// no validation rules for RecipientID
+
+	// no validation rules for Reason
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationStatusValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationStatusValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDeliveredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "DeliveredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDeliveredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationStatusValidationError{
+				field:  "DeliveredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReadAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, NotificationStatusValidationError{
+					field:  "ReadAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReadAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return NotificationStatusValidationError{
+				field:  "ReadAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return NotificationStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// NotificationStatusMultiError is an error wrapping multiple validation errors
+// returned by NotificationStatus.ValidateAll() if the designated constraints
+// aren't met.
+type NotificationStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NotificationStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NotificationStatusMultiError) AllErrors() []error { return m }
+
+// NotificationStatusValidationError is the validation error returned by
+// NotificationStatus.Validate if the designated constraints aren't met.
+type NotificationStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NotificationStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NotificationStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NotificationStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NotificationStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NotificationStatusValidationError) ErrorName() string {
+	return "NotificationStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NotificationStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNotificationStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NotificationStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NotificationStatusValidationError{}
+
+// Validate checks the field values on GetNotificationStatusResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetNotificationStatusResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetNotificationStatusResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetNotificationStatusResponseMultiError, or nil if none found.
+func (m *GetNotificationStatusResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetNotificationStatusResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CommentID
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetNotificationStatusResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetNotificationStatusResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetNotificationStatusResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetNotificationStatusResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetNotificationStatusResponseMultiError is an error wrapping multiple
+// validation errors returned by GetNotificationStatusResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetNotificationStatusResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetNotificationStatusResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetNotificationStatusResponseMultiError) AllErrors() []error { return m }
+
+// GetNotificationStatusResponseValidationError is the validation error
+// returned by GetNotificationStatusResponse.Validate if the designated
+// constraints aren't met.
+type GetNotificationStatusResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetNotificationStatusResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetNotificationStatusResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetNotificationStatusResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetNotificationStatusResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetNotificationStatusResponseValidationError) ErrorName() string {
+	return "GetNotificationStatusResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetNotificationStatusResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetNotificationStatusResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetNotificationStatusResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetNotificationStatusResponseValidationError{}
+
+// Validate checks the field values on SubscribeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeRequestMultiError, or nil if none found.
+func (m *SubscribeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := SubscribeRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetProductID() <= 0 {
+		err := SubscribeRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubscribeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeRequestMultiError is an error wrapping multiple validation errors
+// returned by SubscribeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SubscribeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeRequestMultiError) AllErrors() []error { return m }
+
+// SubscribeRequestValidationError is the validation error returned by
+// SubscribeRequest.Validate if the designated constraints aren't met.
+type SubscribeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeRequestValidationError) ErrorName() string { return "SubscribeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscribeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeRequestValidationError{}
+
+// Validate checks the field values on SubscribeResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeResponseMultiError, or nil if none found.
+func (m *SubscribeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return SubscribeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeResponseMultiError is an error wrapping multiple validation errors
+// returned by SubscribeResponse.ValidateAll() if the designated constraints
+// aren't met.
+type SubscribeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeResponseMultiError) AllErrors() []error { return m }
+
+// SubscribeResponseValidationError is the validation error returned by
+// SubscribeResponse.Validate if the designated constraints aren't met.
+type SubscribeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeResponseValidationError) ErrorName() string {
+	return "SubscribeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SubscribeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeResponseValidationError{}
+
+// Validate checks the field values on UnsubscribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeRequestMultiError, or nil if none found.
+func (m *UnsubscribeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := UnsubscribeRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetProductID() <= 0 {
+		err := UnsubscribeRequestValidationError{
+			field:  "ProductID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return UnsubscribeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeRequestMultiError is an error wrapping multiple validation errors
+// returned by UnsubscribeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type UnsubscribeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeRequestMultiError) AllErrors() []error { return m }
+
+// UnsubscribeRequestValidationError is the validation error returned by
+// UnsubscribeRequest.Validate if the designated constraints aren't met.
+type UnsubscribeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeRequestValidationError) ErrorName() string {
+	return "UnsubscribeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeRequestValidationError{}
+
+// Validate checks the field values on UnsubscribeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UnsubscribeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UnsubscribeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UnsubscribeResponseMultiError, or nil if none found.
+func (m *UnsubscribeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UnsubscribeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Removed
+
+	if len(errors) > 0 {
+		return UnsubscribeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UnsubscribeResponseMultiError is an error wrapping multiple validation
+// errors returned by UnsubscribeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UnsubscribeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UnsubscribeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UnsubscribeResponseMultiError) AllErrors() []error { return m }
+
+// UnsubscribeResponseValidationError is the validation error returned by
+// UnsubscribeResponse.Validate if the designated constraints aren't met.
+type UnsubscribeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UnsubscribeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UnsubscribeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UnsubscribeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UnsubscribeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UnsubscribeResponseValidationError) ErrorName() string {
+	return "UnsubscribeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UnsubscribeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUnsubscribeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UnsubscribeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UnsubscribeResponseValidationError{}
+
+// Validate checks the field values on ListSubscriptionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubscriptionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubscriptionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubscriptionsRequestMultiError, or nil if none found.
+func (m *ListSubscriptionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubscriptionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserID() <= 0 {
+		err := ListSubscriptionsRequestValidationError{
+			field:  "UserID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListSubscriptionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubscriptionsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListSubscriptionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListSubscriptionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubscriptionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubscriptionsRequestMultiError) AllErrors() []error { return m }
+
+// ListSubscriptionsRequestValidationError is the validation error returned by
+// ListSubscriptionsRequest.Validate if the designated constraints aren't met.
+type ListSubscriptionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubscriptionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubscriptionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubscriptionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubscriptionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubscriptionsRequestValidationError) ErrorName() string {
+	return "ListSubscriptionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubscriptionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubscriptionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubscriptionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubscriptionsRequestValidationError{}
+
+// Validate checks the field values on Subscription with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Subscription) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Subscription with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SubscriptionMultiError, or
+// nil if none found.
+func (m *Subscription) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Subscription) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProductID
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SubscriptionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SubscriptionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SubscriptionMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscriptionMultiError is an error wrapping multiple validation errors
+// returned by Subscription.ValidateAll() if the designated constraints aren't met.
+type SubscriptionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscriptionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscriptionMultiError) AllErrors() []error { return m }
+
+// SubscriptionValidationError is the validation error returned by
+// Subscription.Validate if the designated constraints aren't met.
+type SubscriptionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscriptionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscriptionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscriptionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscriptionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscriptionValidationError) ErrorName() string { return "SubscriptionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscriptionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscription.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscriptionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscriptionValidationError{}
+
+// Validate checks the field values on ListSubscriptionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListSubscriptionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListSubscriptionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListSubscriptionsResponseMultiError, or nil if none found.
+func (m *ListSubscriptionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListSubscriptionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserID
+
+	for idx, item := range m.GetSubscriptions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSubscriptionsResponseValidationError{
+						field:  fmt.Sprintf("Subscriptions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSubscriptionsResponseValidationError{
+					field:  fmt.Sprintf("Subscriptions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListSubscriptionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListSubscriptionsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListSubscriptionsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListSubscriptionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListSubscriptionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListSubscriptionsResponseMultiError) AllErrors() []error { return m }
+
+// ListSubscriptionsResponseValidationError is the validation error returned by
+// ListSubscriptionsResponse.Validate if the designated constraints aren't met.
+type ListSubscriptionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListSubscriptionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListSubscriptionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListSubscriptionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListSubscriptionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListSubscriptionsResponseValidationError) ErrorName() string {
+	return "ListSubscriptionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListSubscriptionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListSubscriptionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListSubscriptionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListSubscriptionsResponseValidationError{}
+
+// Validate checks the field values on OutboxFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutboxFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutboxFilter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutboxFilterMultiError, or
+// nil if none found.
+func (m *OutboxFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutboxFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _OutboxFilter_Status_InLookup[m.GetStatus()]; !ok {
+		err := OutboxFilterValidationError{
+			field:  "Status",
+			reason: "value must be in list [ new muted send delivered read canceled]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutboxFilterValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutboxFilterValidationError{
+					field:  "From",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutboxFilterValidationError{
+				field:  "From",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutboxFilterValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutboxFilterValidationError{
+					field:  "To",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutboxFilterValidationError{
+				field:  "To",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetFromID() < 0 {
+		err := OutboxFilterValidationError{
+			field:  "FromID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToID() < 0 {
+		err := OutboxFilterValidationError{
+			field:  "ToID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := OutboxFilterValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OutboxFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutboxFilterMultiError is an error wrapping multiple validation errors
+// returned by OutboxFilter.ValidateAll() if the designated constraints aren't met.
+type OutboxFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutboxFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutboxFilterMultiError) AllErrors() []error { return m }
+
+// OutboxFilterValidationError is the validation error returned by
+// OutboxFilter.Validate if the designated constraints aren't met.
+type OutboxFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutboxFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutboxFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutboxFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutboxFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutboxFilterValidationError) ErrorName() string { return "OutboxFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutboxFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutboxFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutboxFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutboxFilterValidationError{}
+
+var _OutboxFilter_Status_InLookup = map[string]struct{}{
+	"":          {},
+	"new":       {},
+	"muted":     {},
+	"send":      {},
+	"delivered": {},
+	"read":      {},
+	"canceled":  {},
+}
+
+// Validate checks the field values on OutboxNotification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OutboxNotification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutboxNotification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OutboxNotificationMultiError, or nil if none found.
+func (m *OutboxNotification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutboxNotification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for NotificationID
+
+	// no validation rules for CommentID
+
+	// no validation rules for ProductID
+
+	// no validation rules for RecipientID
+
+	// no validation rules for Reason
+
+	// no validation rules for Status
+
+	// no validation rules for TraceParent
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutboxNotificationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutboxNotificationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutboxNotificationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutboxNotificationValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutboxNotificationValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutboxNotificationValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Payload
+
+	if len(errors) > 0 {
+		return OutboxNotificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutboxNotificationMultiError is an error wrapping multiple validation errors
+// returned by OutboxNotification.ValidateAll() if the designated constraints
+// aren't met.
+type OutboxNotificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutboxNotificationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutboxNotificationMultiError) AllErrors() []error { return m }
+
+// OutboxNotificationValidationError is the validation error returned by
+// OutboxNotification.Validate if the designated constraints aren't met.
+type OutboxNotificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutboxNotificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutboxNotificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutboxNotificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutboxNotificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutboxNotificationValidationError) ErrorName() string {
+	return "OutboxNotificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OutboxNotificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutboxNotification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutboxNotificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutboxNotificationValidationError{}
+
+// Validate checks the field values on ListOutboxRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOutboxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOutboxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOutboxRequestMultiError, or nil if none found.
+func (m *ListOutboxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOutboxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOutboxRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOutboxRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOutboxRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListOutboxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOutboxRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOutboxRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOutboxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOutboxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOutboxRequestMultiError) AllErrors() []error { return m }
+
+// ListOutboxRequestValidationError is the validation error returned by
+// ListOutboxRequest.Validate if the designated constraints aren't met.
+type ListOutboxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOutboxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOutboxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOutboxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOutboxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOutboxRequestValidationError) ErrorName() string {
+	return "ListOutboxRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOutboxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOutboxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOutboxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOutboxRequestValidationError{}
+
+// Validate checks the field values on ListOutboxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOutboxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOutboxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOutboxResponseMultiError, or nil if none found.
+func (m *ListOutboxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOutboxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOutboxResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOutboxResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOutboxResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOutboxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOutboxResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOutboxResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOutboxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOutboxResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOutboxResponseMultiError) AllErrors() []error { return m }
+
+// ListOutboxResponseValidationError is the validation error returned by
+// ListOutboxResponse.Validate if the designated constraints aren't met.
+type ListOutboxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOutboxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOutboxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOutboxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOutboxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOutboxResponseValidationError) ErrorName() string {
+	return "ListOutboxResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOutboxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOutboxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOutboxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOutboxResponseValidationError{}
+
+// Validate checks the field values on ReplayOutboxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayOutboxRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayOutboxRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayOutboxRequestMultiError, or nil if none found.
+func (m *ReplayOutboxRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayOutboxRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFilter() == nil {
+		err := ReplayOutboxRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplayOutboxRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplayOutboxRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplayOutboxRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Topic
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ReplayOutboxRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayOutboxRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayOutboxRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayOutboxRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayOutboxRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayOutboxRequestMultiError) AllErrors() []error { return m }
+
+// ReplayOutboxRequestValidationError is the validation error returned by
+// ReplayOutboxRequest.Validate if the designated constraints aren't met.
+type ReplayOutboxRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayOutboxRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayOutboxRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayOutboxRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayOutboxRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayOutboxRequestValidationError) ErrorName() string {
+	return "ReplayOutboxRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayOutboxRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayOutboxRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayOutboxRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayOutboxRequestValidationError{}
+
+// Validate checks the field values on ReplayOutboxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayOutboxResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayOutboxResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayOutboxResponseMultiError, or nil if none found.
+func (m *ReplayOutboxResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayOutboxResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNotifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplayOutboxResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplayOutboxResponseValidationError{
+						field:  fmt.Sprintf("Notifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplayOutboxResponseValidationError{
+					field:  fmt.Sprintf("Notifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ReplayOutboxResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayOutboxResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplayOutboxResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayOutboxResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayOutboxResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayOutboxResponseMultiError) AllErrors() []error { return m }
+
+// ReplayOutboxResponseValidationError is the validation error returned by
+// ReplayOutboxResponse.Validate if the designated constraints aren't met.
+type ReplayOutboxResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayOutboxResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayOutboxResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayOutboxResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayOutboxResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayOutboxResponseValidationError) ErrorName() string {
+	return "ReplayOutboxResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayOutboxResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayOutboxResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayOutboxResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayOutboxResponseValidationError{}
+
+// Validate checks the field values on OutboxMessageFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OutboxMessageFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutboxMessageFilter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OutboxMessageFilterMultiError, or nil if none found.
+func (m *OutboxMessageFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutboxMessageFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _OutboxMessageFilter_Status_InLookup[m.GetStatus()]; !ok {
+		err := OutboxMessageFilterValidationError{
+			field:  "Status",
+			reason: "value must be in list [ new send]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Topic
+
+	if m.GetFromID() < 0 {
+		err := OutboxMessageFilterValidationError{
+			field:  "FromID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetToID() < 0 {
+		err := OutboxMessageFilterValidationError{
+			field:  "ToID",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val < 0 || val > 1000 {
+		err := OutboxMessageFilterValidationError{
+			field:  "Limit",
+			reason: "value must be inside range [0, 1000]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OutboxMessageFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutboxMessageFilterMultiError is an error wrapping multiple validation
+// errors returned by OutboxMessageFilter.ValidateAll() if the designated
+// constraints aren't met.
+type OutboxMessageFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutboxMessageFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutboxMessageFilterMultiError) AllErrors() []error { return m }
+
+// OutboxMessageFilterValidationError is the validation error returned by
+// OutboxMessageFilter.Validate if the designated constraints aren't met.
+type OutboxMessageFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutboxMessageFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutboxMessageFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutboxMessageFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutboxMessageFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutboxMessageFilterValidationError) ErrorName() string {
+	return "OutboxMessageFilterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OutboxMessageFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutboxMessageFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutboxMessageFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutboxMessageFilterValidationError{}
+
+var _OutboxMessageFilter_Status_InLookup = map[string]struct{}{
+	"":     {},
+	"new":  {},
+	"send": {},
+}
+
+// Validate checks the field values on OutboxMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OutboxMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OutboxMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OutboxMessageMultiError, or
+// nil if none found.
+func (m *OutboxMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OutboxMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Topic
+
+	// no validation rules for Key
+
+	// no validation rules for Headers
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutboxMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutboxMessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutboxMessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSentAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OutboxMessageValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OutboxMessageValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OutboxMessageValidationError{
+				field:  "SentAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Payload
+
+	if len(errors) > 0 {
+		return OutboxMessageMultiError(errors)
+	}
+
+	return nil
+}
+
+// OutboxMessageMultiError is an error wrapping multiple validation errors
+// returned by OutboxMessage.ValidateAll() if the designated constraints
+// aren't met.
+type OutboxMessageMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OutboxMessageMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OutboxMessageMultiError) AllErrors() []error { return m }
+
+// OutboxMessageValidationError is the validation error returned by
+// OutboxMessage.Validate if the designated constraints aren't met.
+type OutboxMessageValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OutboxMessageValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OutboxMessageValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OutboxMessageValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OutboxMessageValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OutboxMessageValidationError) ErrorName() string { return "OutboxMessageValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OutboxMessageValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOutboxMessage.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OutboxMessageValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OutboxMessageValidationError{}
+
+// Validate checks the field values on ListOutboxMessagesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOutboxMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOutboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOutboxMessagesRequestMultiError, or nil if none found.
+func (m *ListOutboxMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOutboxMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOutboxMessagesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOutboxMessagesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOutboxMessagesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListOutboxMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOutboxMessagesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListOutboxMessagesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListOutboxMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOutboxMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOutboxMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ListOutboxMessagesRequestValidationError is the validation error returned by
+// ListOutboxMessagesRequest.Validate if the designated constraints aren't met.
+type ListOutboxMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOutboxMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOutboxMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOutboxMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOutboxMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOutboxMessagesRequestValidationError) ErrorName() string {
+	return "ListOutboxMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOutboxMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOutboxMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOutboxMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOutboxMessagesRequestValidationError{}
+
+// Validate checks the field values on ListOutboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListOutboxMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOutboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOutboxMessagesResponseMultiError, or nil if none found.
+func (m *ListOutboxMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOutboxMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOutboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOutboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOutboxMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListOutboxMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOutboxMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListOutboxMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListOutboxMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOutboxMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOutboxMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ListOutboxMessagesResponseValidationError is the validation error returned
+// by ListOutboxMessagesResponse.Validate if the designated constraints aren't met.
+type ListOutboxMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOutboxMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOutboxMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOutboxMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOutboxMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOutboxMessagesResponseValidationError) ErrorName() string {
+	return "ListOutboxMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOutboxMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOutboxMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOutboxMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOutboxMessagesResponseValidationError{}
+
+// Validate checks the field values on ReplayOutboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayOutboxMessagesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayOutboxMessagesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayOutboxMessagesRequestMultiError, or nil if none found.
+func (m *ReplayOutboxMessagesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayOutboxMessagesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFilter() == nil {
+		err := ReplayOutboxMessagesRequestValidationError{
+			field:  "Filter",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReplayOutboxMessagesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReplayOutboxMessagesRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReplayOutboxMessagesRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ReplayOutboxMessagesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayOutboxMessagesRequestMultiError is an error wrapping multiple
+// validation errors returned by ReplayOutboxMessagesRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReplayOutboxMessagesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayOutboxMessagesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayOutboxMessagesRequestMultiError) AllErrors() []error { return m }
+
+// ReplayOutboxMessagesRequestValidationError is the validation error returned
+// by ReplayOutboxMessagesRequest.Validate if the designated constraints
+// aren't met.
+type ReplayOutboxMessagesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayOutboxMessagesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayOutboxMessagesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayOutboxMessagesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayOutboxMessagesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayOutboxMessagesRequestValidationError) ErrorName() string {
+	return "ReplayOutboxMessagesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayOutboxMessagesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayOutboxMessagesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayOutboxMessagesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayOutboxMessagesRequestValidationError{}
+
+// Validate checks the field values on ReplayOutboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayOutboxMessagesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayOutboxMessagesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayOutboxMessagesResponseMultiError, or nil if none found.
+func (m *ReplayOutboxMessagesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayOutboxMessagesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMessages() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplayOutboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplayOutboxMessagesResponseValidationError{
+						field:  fmt.Sprintf("Messages[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplayOutboxMessagesResponseValidationError{
+					field:  fmt.Sprintf("Messages[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ReplayOutboxMessagesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayOutboxMessagesResponseMultiError is an error wrapping multiple
+// validation errors returned by ReplayOutboxMessagesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ReplayOutboxMessagesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayOutboxMessagesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayOutboxMessagesResponseMultiError) AllErrors() []error { return m }
+
+// ReplayOutboxMessagesResponseValidationError is the validation error returned
+// by ReplayOutboxMessagesResponse.Validate if the designated constraints
+// aren't met.
+type ReplayOutboxMessagesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayOutboxMessagesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayOutboxMessagesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayOutboxMessagesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayOutboxMessagesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayOutboxMessagesResponseValidationError) ErrorName() string {
+	return "ReplayOutboxMessagesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayOutboxMessagesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayOutboxMessagesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayOutboxMessagesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayOutboxMessagesResponseValidationError{}
@@ -24,6 +24,15 @@ const _ = grpc.SupportPackageIsVersion7
 type CommentsClient interface {
 	CreateComment(ctx context.Context, in *CreateCommentRequest, opts ...grpc.CallOption) (*CreateCommentResponse, error)
 	GetComments(ctx context.Context, in *GetCommentsRequest, opts ...grpc.CallOption) (*GetCommentsResponse, error)
+	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
+	ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error)
+	SetNotificationPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	SetNotificationChannel(ctx context.Context, in *SetNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error)
+	GetNotificationStatus(ctx context.Context, in *GetNotificationStatusRequest, opts ...grpc.CallOption) (*GetNotificationStatusResponse, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error)
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
 }
 
 type commentsClient struct {
@@ -52,12 +61,102 @@ func (c *commentsClient) GetComments(ctx context.Context, in *GetCommentsRequest
 	return out, nil
 }
 
+func (c *commentsClient) RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error) {
+	out := new(RegisterWebhookResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/RegisterWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) ListWebhookDeliveries(ctx context.Context, in *ListWebhookDeliveriesRequest, opts ...grpc.CallOption) (*ListWebhookDeliveriesResponse, error) {
+	out := new(ListWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/ListWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) SetNotificationPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/SetNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/GetNotificationPreferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) SetNotificationChannel(ctx context.Context, in *SetNotificationChannelRequest, opts ...grpc.CallOption) (*NotificationChannel, error) {
+	out := new(NotificationChannel)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/SetNotificationChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) GetNotificationStatus(ctx context.Context, in *GetNotificationStatusRequest, opts ...grpc.CallOption) (*GetNotificationStatusResponse, error) {
+	out := new(GetNotificationStatusResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/GetNotificationStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (*SubscribeResponse, error) {
+	out := new(SubscribeResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/Subscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeResponse, error) {
+	out := new(UnsubscribeResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/Unsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.Comments/ListSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommentsServer is the server API for Comments service.
 // All implementations must embed UnimplementedCommentsServer
 // for forward compatibility
 type CommentsServer interface {
 	CreateComment(context.Context, *CreateCommentRequest) (*CreateCommentResponse, error)
 	GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error)
+	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
+	ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error)
+	SetNotificationPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferences, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error)
+	SetNotificationChannel(context.Context, *SetNotificationChannelRequest) (*NotificationChannel, error)
+	GetNotificationStatus(context.Context, *GetNotificationStatusRequest) (*GetNotificationStatusResponse, error)
+	Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error)
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
 	mustEmbedUnimplementedCommentsServer()
 }
 
@@ -71,6 +170,33 @@ func (UnimplementedCommentsServer) CreateComment(context.Context, *CreateComment
 func (UnimplementedCommentsServer) GetComments(context.Context, *GetCommentsRequest) (*GetCommentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetComments not implemented")
 }
+func (UnimplementedCommentsServer) RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterWebhook not implemented")
+}
+func (UnimplementedCommentsServer) ListWebhookDeliveries(context.Context, *ListWebhookDeliveriesRequest) (*ListWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookDeliveries not implemented")
+}
+func (UnimplementedCommentsServer) SetNotificationPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationPreferences not implemented")
+}
+func (UnimplementedCommentsServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedCommentsServer) SetNotificationChannel(context.Context, *SetNotificationChannelRequest) (*NotificationChannel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationChannel not implemented")
+}
+func (UnimplementedCommentsServer) GetNotificationStatus(context.Context, *GetNotificationStatusRequest) (*GetNotificationStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationStatus not implemented")
+}
+func (UnimplementedCommentsServer) Subscribe(context.Context, *SubscribeRequest) (*SubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedCommentsServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedCommentsServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
 func (UnimplementedCommentsServer) mustEmbedUnimplementedCommentsServer() {}
 
 // UnsafeCommentsServer may be embedded to opt out of forward compatibility for this service.
@@ -120,6 +246,168 @@ func _Comments_GetComments_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Comments_RegisterWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).RegisterWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/RegisterWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).RegisterWebhook(ctx, req.(*RegisterWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_ListWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).ListWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/ListWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).ListWebhookDeliveries(ctx, req.(*ListWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_SetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).SetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/SetNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).SetNotificationPreferences(ctx, req.(*SetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/GetNotificationPreferences",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_SetNotificationChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).SetNotificationChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/SetNotificationChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).SetNotificationChannel(ctx, req.(*SetNotificationChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_GetNotificationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).GetNotificationStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/GetNotificationStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).GetNotificationStatus(ctx, req.(*GetNotificationStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_Subscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).Subscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/Subscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).Subscribe(ctx, req.(*SubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/Unsubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Comments_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.Comments/ListSubscriptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Comments_ServiceDesc is the grpc.ServiceDesc for Comments service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -135,6 +423,244 @@ var Comments_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetComments",
 			Handler:    _Comments_GetComments_Handler,
 		},
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _Comments_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookDeliveries",
+			Handler:    _Comments_ListWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "SetNotificationPreferences",
+			Handler:    _Comments_SetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _Comments_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "SetNotificationChannel",
+			Handler:    _Comments_SetNotificationChannel_Handler,
+		},
+		{
+			MethodName: "GetNotificationStatus",
+			Handler:    _Comments_GetNotificationStatus_Handler,
+		},
+		{
+			MethodName: "Subscribe",
+			Handler:    _Comments_Subscribe_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _Comments_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _Comments_ListSubscriptions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "comments.proto",
+}
+
+// CommentsAdminClient is the client API for CommentsAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CommentsAdminClient interface {
+	// ListOutbox returns outbox notifications ordered by id with the payloads they are published with
+	ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*ListOutboxResponse, error)
+	// ReplayOutbox enqueues the selected notifications to a logical outbox topic again
+	ReplayOutbox(ctx context.Context, in *ReplayOutboxRequest, opts ...grpc.CallOption) (*ReplayOutboxResponse, error)
+	// ListOutboxMessages returns messages of the generic outbox ordered by id
+	ListOutboxMessages(ctx context.Context, in *ListOutboxMessagesRequest, opts ...grpc.CallOption) (*ListOutboxMessagesResponse, error)
+	// ReplayOutboxMessages enqueues copies of the selected generic outbox messages
+	ReplayOutboxMessages(ctx context.Context, in *ReplayOutboxMessagesRequest, opts ...grpc.CallOption) (*ReplayOutboxMessagesResponse, error)
+}
+
+type commentsAdminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCommentsAdminClient(cc grpc.ClientConnInterface) CommentsAdminClient {
+	return &commentsAdminClient{cc}
+}
+
+func (c *commentsAdminClient) ListOutbox(ctx context.Context, in *ListOutboxRequest, opts ...grpc.CallOption) (*ListOutboxResponse, error) {
+	out := new(ListOutboxResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.CommentsAdmin/ListOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsAdminClient) ReplayOutbox(ctx context.Context, in *ReplayOutboxRequest, opts ...grpc.CallOption) (*ReplayOutboxResponse, error) {
+	out := new(ReplayOutboxResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.CommentsAdmin/ReplayOutbox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsAdminClient) ListOutboxMessages(ctx context.Context, in *ListOutboxMessagesRequest, opts ...grpc.CallOption) (*ListOutboxMessagesResponse, error) {
+	out := new(ListOutboxMessagesResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.CommentsAdmin/ListOutboxMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commentsAdminClient) ReplayOutboxMessages(ctx context.Context, in *ReplayOutboxMessagesRequest, opts ...grpc.CallOption) (*ReplayOutboxMessagesResponse, error) {
+	out := new(ReplayOutboxMessagesResponse)
+	err := c.cc.Invoke(ctx, "/example.comments.pkg.api.comments.v1.CommentsAdmin/ReplayOutboxMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CommentsAdminServer is the server API for CommentsAdmin service.
+// All implementations must embed UnimplementedCommentsAdminServer
+// for forward compatibility
+type CommentsAdminServer interface {
+	// ListOutbox returns outbox notifications ordered by id with the payloads they are published with
+	ListOutbox(context.Context, *ListOutboxRequest) (*ListOutboxResponse, error)
+	// ReplayOutbox enqueues the selected notifications to a logical outbox topic again
+	ReplayOutbox(context.Context, *ReplayOutboxRequest) (*ReplayOutboxResponse, error)
+	// ListOutboxMessages returns messages of the generic outbox ordered by id
+	ListOutboxMessages(context.Context, *ListOutboxMessagesRequest) (*ListOutboxMessagesResponse, error)
+	// ReplayOutboxMessages enqueues copies of the selected generic outbox messages
+	ReplayOutboxMessages(context.Context, *ReplayOutboxMessagesRequest) (*ReplayOutboxMessagesResponse, error)
+	mustEmbedUnimplementedCommentsAdminServer()
+}
+
+// UnimplementedCommentsAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedCommentsAdminServer struct {
+}
+
+func (UnimplementedCommentsAdminServer) ListOutbox(context.Context, *ListOutboxRequest) (*ListOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutbox not implemented")
+}
+func (UnimplementedCommentsAdminServer) ReplayOutbox(context.Context, *ReplayOutboxRequest) (*ReplayOutboxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOutbox not implemented")
+}
+func (UnimplementedCommentsAdminServer) ListOutboxMessages(context.Context, *ListOutboxMessagesRequest) (*ListOutboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxMessages not implemented")
+}
+func (UnimplementedCommentsAdminServer) ReplayOutboxMessages(context.Context, *ReplayOutboxMessagesRequest) (*ReplayOutboxMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayOutboxMessages not implemented")
+}
+func (UnimplementedCommentsAdminServer) mustEmbedUnimplementedCommentsAdminServer() {}
+
+// UnsafeCommentsAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CommentsAdminServer will
+// result in compilation errors.
+type UnsafeCommentsAdminServer interface {
+	mustEmbedUnimplementedCommentsAdminServer()
+}
+
+func RegisterCommentsAdminServer(s grpc.ServiceRegistrar, srv CommentsAdminServer) {
+	s.RegisterService(&CommentsAdmin_ServiceDesc, srv)
+}
+
+func _CommentsAdmin_ListOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsAdminServer).ListOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.CommentsAdmin/ListOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsAdminServer).ListOutbox(ctx, req.(*ListOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsAdmin_ReplayOutbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsAdminServer).ReplayOutbox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.CommentsAdmin/ReplayOutbox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsAdminServer).ReplayOutbox(ctx, req.(*ReplayOutboxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsAdmin_ListOutboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsAdminServer).ListOutboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.CommentsAdmin/ListOutboxMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsAdminServer).ListOutboxMessages(ctx, req.(*ListOutboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommentsAdmin_ReplayOutboxMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayOutboxMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommentsAdminServer).ReplayOutboxMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.comments.pkg.api.comments.v1.CommentsAdmin/ReplayOutboxMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommentsAdminServer).ReplayOutboxMessages(ctx, req.(*ReplayOutboxMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CommentsAdmin_ServiceDesc is the grpc.ServiceDesc for CommentsAdmin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CommentsAdmin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "example.comments.pkg.api.comments.v1.CommentsAdmin",
+	HandlerType: (*CommentsAdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOutbox",
+			Handler:    _CommentsAdmin_ListOutbox_Handler,
+		},
+		{
+			MethodName: "ReplayOutbox",
+			Handler:    _CommentsAdmin_ReplayOutbox_Handler,
+		},
+		{
+			MethodName: "ListOutboxMessages",
+			Handler:    _CommentsAdmin_ListOutboxMessages_Handler,
+		},
+		{
+			MethodName: "ReplayOutboxMessages",
+			Handler:    _CommentsAdmin_ReplayOutboxMessages_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "comments.proto",
@@ -1,14 +1,27 @@
 package main
 
 import (
+	"example/external/internal/catalog"
+	"example/external/internal/events"
+	"example/external/internal/faults"
 	"example/external/internal/server"
 	"example/external/internal/server/mw"
 	desc "example/external/pkg/api/v1"
 	"fmt"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 	"log"
 	"net"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	defaultProductsTopic = "products.events"
+	defaultUsersTopic    = "users.events"
 )
 
 func main() {
@@ -26,19 +39,102 @@ func listenAndServe() error {
 		return fmt.Errorf("listen and serve app failed: %w", err)
 	}
 
+	injector, err := newInjector()
+	if err != nil {
+		return err
+	}
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			mw.Logger,
+			injector.Interceptor,
 			mw.Validate,
 		),
 	)
 
 	reflection.Register(grpcServer)
-	controller := &server.Controller{}
+	publisher, err := newPublisher()
+	if err != nil {
+		return err
+	}
+	cat, err := newCatalog()
+	if err != nil {
+		return err
+	}
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	controller := server.NewController(cat, publisher, injector, healthServer,
+		getenv("PRODUCTS_EVENTS_TOPIC", defaultProductsTopic),
+		getenv("USERS_EVENTS_TOPIC", defaultUsersTopic))
 	desc.RegisterUsersServer(grpcServer, controller)
 	desc.RegisterProductsServer(grpcServer, controller)
+	desc.RegisterAdminServer(grpcServer, controller)
+	server.SetServing(healthServer, true)
 	if err = grpcServer.Serve(list); err != nil {
 		log.Fatalf("Server err: %e", err)
 	}
 	return nil
 }
+
+// newPublisher sends events to KAFKA_BROKERS (comma separated) or only logs them when it is empty.
+func newPublisher() (events.Publisher, error) {
+	brokers := os.Getenv("KAFKA_BROKERS")
+	if brokers == "" {
+		log.Println("KAFKA_BROKERS is not set, events are only logged")
+		return events.LogPublisher{}, nil
+	}
+	return events.NewKafkaPublisher(strings.Split(brokers, ","))
+}
+
+// newCatalog loads CATALOG_STATE if the file exists, otherwise CATALOG_FIXTURES, otherwise the
+// built-in catalog. With CATALOG_STATE set every change is saved there and survives restarts.
+func newCatalog() (*catalog.Catalog, error) {
+	statePath := os.Getenv("CATALOG_STATE")
+	path := os.Getenv("CATALOG_FIXTURES")
+	if statePath != "" {
+		if _, err := os.Stat(statePath); err == nil {
+			path = statePath
+		}
+	}
+	fixture := catalog.Default()
+	if path != "" {
+		var err error
+		if fixture, err = catalog.Load(path); err != nil {
+			return nil, err
+		}
+		log.Printf("catalog loaded from %s", path)
+	}
+	cat, err := catalog.New(fixture, statePath)
+	if err != nil {
+		return nil, fmt.Errorf("catalog fixture is invalid: %w", err)
+	}
+	return cat, nil
+}
+
+// newInjector applies the faults from FAULTS_CONFIG, FAULTS_SEED overrides the seed of the file.
+func newInjector() (*faults.Injector, error) {
+	injector := faults.NewInjector()
+	conf := faults.Config{}
+	if path := os.Getenv("FAULTS_CONFIG"); path != "" {
+		var err error
+		if conf, err = faults.Load(path); err != nil {
+			return nil, err
+		}
+	}
+	if seed := os.Getenv("FAULTS_SEED"); seed != "" {
+		var err error
+		if conf.Seed, err = strconv.ParseInt(seed, 10, 64); err != nil {
+			return nil, fmt.Errorf("parse FAULTS_SEED failed: %w", err)
+		}
+	}
+	if _, err := injector.Set(conf); err != nil {
+		return nil, fmt.Errorf("faults config is invalid: %w", err)
+	}
+	return injector, nil
+}
+
+func getenv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
@@ -0,0 +1,281 @@
+package catalog
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+)
+
+// User statuses
+const (
+	UserActive = "active"
+	UserBanned = "banned"
+)
+
+// Product statuses
+const (
+	ProductActive  = "active"
+	ProductDeleted = "deleted"
+)
+
+var (
+	ErrUserNotFound    = errors.New("user not found")
+	ErrProductNotFound = errors.New("product not found")
+	ErrAlreadyExists   = errors.New("already exists")
+)
+
+type User struct {
+	ID        int64  `json:"id" yaml:"id"`
+	Name      string `json:"name" yaml:"name"`
+	Status    string `json:"status" yaml:"status"`
+	AvatarURL string `json:"avatar_url,omitempty" yaml:"avatar_url,omitempty"`
+}
+
+type Product struct {
+	ID      int64  `json:"id" yaml:"id"`
+	Name    string `json:"name" yaml:"name"`
+	OwnerID int64  `json:"owner_id" yaml:"owner_id"`
+	Status  string `json:"status" yaml:"status"`
+}
+
+// Catalog is the in-memory stand-in for the products and users services. If statePath is set,
+// the content is saved there after every change.
+type Catalog struct {
+	mu        sync.RWMutex
+	users     map[int64]User
+	products  map[int64]Product
+	statePath string
+}
+
+func New(fixture Fixture, statePath string) (*Catalog, error) {
+	c := &Catalog{
+		users:     make(map[int64]User, len(fixture.Users)),
+		products:  make(map[int64]Product, len(fixture.Products)),
+		statePath: statePath,
+	}
+	for _, user := range fixture.Users {
+		if user.Status == "" {
+			user.Status = UserActive
+		}
+		if user.ID <= 0 || (user.Status != UserActive && user.Status != UserBanned) {
+			return nil, fmt.Errorf("invalid user %d with status %q", user.ID, user.Status)
+		}
+		if _, ok := c.users[user.ID]; ok {
+			return nil, fmt.Errorf("duplicate user %d", user.ID)
+		}
+		c.users[user.ID] = user
+	}
+	for _, product := range fixture.Products {
+		if product.Status == "" {
+			product.Status = ProductActive
+		}
+		if product.ID <= 0 || product.OwnerID <= 0 ||
+			(product.Status != ProductActive && product.Status != ProductDeleted) {
+			return nil, fmt.Errorf("invalid product %d with owner %d and status %q",
+				product.ID, product.OwnerID, product.Status)
+		}
+		if _, ok := c.products[product.ID]; ok {
+			return nil, fmt.Errorf("duplicate product %d", product.ID)
+		}
+		c.products[product.ID] = product
+	}
+	return c, nil
+}
+
+// Users returns the users found by id, banned users included.
+func (c *Catalog) Users(userIDs []int64) []User {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	res := make([]User, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if user, ok := c.users[userID]; ok {
+			res = append(res, user)
+		}
+	}
+	return res
+}
+
+// IsUser reports whether the user exists and is not banned.
+func (c *Catalog) IsUser(userID int64) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	user, ok := c.users[userID]
+	return ok && user.Status == UserActive
+}
+
+// SetBanned bans or unbans the user and reports whether the state changed.
+func (c *Catalog) SetBanned(userID int64, banned bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := c.users[userID]
+	status := UserActive
+	if banned {
+		status = UserBanned
+	}
+	if !ok || user.Status == status {
+		return false
+	}
+	user.Status = status
+	c.users[userID] = user
+	c.save()
+	return true
+}
+
+// Owner returns the owner of an existing product or 0 if there is no such product.
+func (c *Catalog) Owner(productID int64) int64 {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	product, ok := c.products[productID]
+	if !ok || product.Status != ProductActive {
+		return 0
+	}
+	return product.OwnerID
+}
+
+// SetOwner hands the existing product over to the owner and returns the previous owner, it
+// is 0 if there is no such product. The state is not changed if the owner is the same.
+func (c *Catalog) SetOwner(productID int64, ownerID int64) (int64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	product, ok := c.products[productID]
+	if !ok || product.Status != ProductActive {
+		return 0, false
+	}
+	prev := product.OwnerID
+	if prev == ownerID {
+		return prev, false
+	}
+	product.OwnerID = ownerID
+	c.products[productID] = product
+	c.save()
+	return prev, true
+}
+
+// SetDeleted marks the product as deleted or restored and reports whether the state changed.
+func (c *Catalog) SetDeleted(productID int64, deleted bool) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	product, ok := c.products[productID]
+	status := ProductActive
+	if deleted {
+		status = ProductDeleted
+	}
+	if !ok || product.Status == status {
+		return false
+	}
+	product.Status = status
+	c.products[productID] = product
+	c.save()
+	return true
+}
+
+// AddUser adds the user, the status defaults to active.
+func (c *Catalog) AddUser(user User) (User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.users[user.ID]; ok {
+		return User{}, ErrAlreadyExists
+	}
+	if user.Status == "" {
+		user.Status = UserActive
+	}
+	c.users[user.ID] = user
+	c.save()
+	return user, nil
+}
+
+// UpdateUser changes the name and the avatar of the user, the status is changed by SetBanned only.
+func (c *Catalog) UpdateUser(userID int64, name string, avatarURL string) (User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := c.users[userID]
+	if !ok {
+		return User{}, ErrUserNotFound
+	}
+	user.Name = name
+	user.AvatarURL = avatarURL
+	c.users[userID] = user
+	c.save()
+	return user, nil
+}
+
+// RemoveUser removes the user and returns it.
+func (c *Catalog) RemoveUser(userID int64) (User, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	user, ok := c.users[userID]
+	if !ok {
+		return User{}, ErrUserNotFound
+	}
+	delete(c.users, userID)
+	c.save()
+	return user, nil
+}
+
+// AddProduct adds the product, the status defaults to active.
+func (c *Catalog) AddProduct(product Product) (Product, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.products[product.ID]; ok {
+		return Product{}, ErrAlreadyExists
+	}
+	if product.Status == "" {
+		product.Status = ProductActive
+	}
+	c.products[product.ID] = product
+	c.save()
+	return product, nil
+}
+
+// RenameProduct changes the name of the product, the owner and the status are changed by
+// SetOwner and SetDeleted only.
+func (c *Catalog) RenameProduct(productID int64, name string) (Product, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	product, ok := c.products[productID]
+	if !ok {
+		return Product{}, ErrProductNotFound
+	}
+	product.Name = name
+	c.products[productID] = product
+	c.save()
+	return product, nil
+}
+
+// RemoveProduct removes the product and returns it.
+func (c *Catalog) RemoveProduct(productID int64) (Product, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	product, ok := c.products[productID]
+	if !ok {
+		return Product{}, ErrProductNotFound
+	}
+	delete(c.products, productID)
+	c.save()
+	return product, nil
+}
+
+// save persists the catalog, it is called with the lock held. A failed save is only logged,
+// the in-memory state stays the source of truth.
+func (c *Catalog) save() {
+	if c.statePath == "" {
+		return
+	}
+	fixture := Fixture{
+		Users:    make([]User, 0, len(c.users)),
+		Products: make([]Product, 0, len(c.products)),
+	}
+	for _, user := range c.users {
+		fixture.Users = append(fixture.Users, user)
+	}
+	for _, product := range c.products {
+		fixture.Products = append(fixture.Products, product)
+	}
+	sort.Slice(fixture.Users, func(i, j int) bool { return fixture.Users[i].ID < fixture.Users[j].ID })
+	sort.Slice(fixture.Products, func(i, j int) bool { return fixture.Products[i].ID < fixture.Products[j].ID })
+	if err := Save(c.statePath, fixture); err != nil {
+		log.Printf("save catalog to %s failed: %v", c.statePath, err)
+	}
+}
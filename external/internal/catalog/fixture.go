@@ -0,0 +1,100 @@
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultMaxProductID = 100
+	defaultMaxUserID    = 99
+	defaultOwnerOffset  = 73
+)
+
+// Fixture is the catalog content stored in a YAML or JSON file, the format is chosen by the
+// file extension.
+type Fixture struct {
+	Users    []User    `json:"users" yaml:"users"`
+	Products []Product `json:"products" yaml:"products"`
+}
+
+// Default returns the built-in catalog: users 1..99 and products 1..100 owned by productID+73.
+func Default() Fixture {
+	fixture := Fixture{
+		Users:    make([]User, 0, defaultMaxUserID),
+		Products: make([]Product, 0, defaultMaxProductID),
+	}
+	for id := int64(1); id <= defaultMaxUserID; id++ {
+		fixture.Users = append(fixture.Users, User{
+			ID:     id,
+			Name:   fmt.Sprintf("user-%d", id),
+			Status: UserActive,
+		})
+	}
+	for id := int64(1); id <= defaultMaxProductID; id++ {
+		fixture.Products = append(fixture.Products, Product{
+			ID:      id,
+			Name:    fmt.Sprintf("product-%d", id),
+			OwnerID: id + defaultOwnerOffset,
+			Status:  ProductActive,
+		})
+	}
+	return fixture
+}
+
+// Load reads the fixture file.
+func Load(path string) (Fixture, error) {
+	fixture := Fixture{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fixture, fmt.Errorf("read fixture failed: %w", err)
+	}
+	if isJSON(path) {
+		err = json.Unmarshal(data, &fixture)
+	} else {
+		err = yaml.Unmarshal(data, &fixture)
+	}
+	if err != nil {
+		return fixture, fmt.Errorf("parse fixture %s failed: %w", path, err)
+	}
+	return fixture, nil
+}
+
+// Save writes the fixture to a temporary file and renames it, so the file is never left half written.
+func Save(path string, fixture Fixture) error {
+	var data []byte
+	var err error
+	if isJSON(path) {
+		data, err = json.MarshalIndent(fixture, "", "  ")
+	} else {
+		data, err = yaml.Marshal(fixture)
+	}
+	if err != nil {
+		return fmt.Errorf("marshal fixture failed: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("create temp file failed: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("write fixture failed: %w", err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("close fixture failed: %w", err)
+	}
+	if err = os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename fixture failed: %w", err)
+	}
+	return nil
+}
+
+func isJSON(path string) bool {
+	return strings.EqualFold(filepath.Ext(path), ".json")
+}
@@ -0,0 +1,77 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadExampleFixture(t *testing.T) {
+	fixture, err := Load(filepath.Join("..", "..", "fixtures", "catalog.yaml"))
+	require.NoError(t, err, "Load failed")
+	require.NotEmpty(t, fixture.Users, "Users are empty")
+	require.NotEmpty(t, fixture.Products, "Products are empty")
+
+	c, err := New(fixture, "")
+	require.NoError(t, err, "Example fixture is invalid")
+	for _, product := range fixture.Products {
+		owner := product.OwnerID
+		if product.Status == ProductDeleted {
+			owner = 0
+		}
+		require.Equal(t, owner, c.Owner(product.ID), "Owner of product %d mismatch", product.ID)
+	}
+}
+
+func TestLoadJSONFixture(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.json")
+	data := `{"users":[{"id":1,"name":"Анна","status":"banned"}],"products":[{"id":2,"name":"Чайник","owner_id":101}]}`
+	require.NoError(t, os.WriteFile(path, []byte(data), 0o600), "WriteFile failed")
+
+	fixture, err := Load(path)
+	require.NoError(t, err, "Load failed")
+	require.Equal(t, []User{{ID: 1, Name: "Анна", Status: UserBanned}}, fixture.Users, "Users mismatch")
+	require.Equal(t, []Product{{ID: 2, Name: "Чайник", OwnerID: 101}}, fixture.Products, "Products mismatch")
+
+	c, err := New(fixture, "")
+	require.NoError(t, err, "New failed")
+	require.False(t, c.IsUser(1), "Banned user is a user")
+	require.Equal(t, int64(101), c.Owner(2), "Owner mismatch")
+}
+
+func TestLoadMalformedFixture(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "catalog.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("users: {"), 0o600), "WriteFile failed")
+	_, err := Load(path)
+	require.Error(t, err, "Malformed fixture is loaded")
+}
+
+func TestNewRejectsInvalidFixture(t *testing.T) {
+	tests := map[string]Fixture{
+		"user without id":        {Users: []User{{Name: "user"}}},
+		"unknown user status":    {Users: []User{{ID: 1, Status: "deleted"}}},
+		"duplicate user":         {Users: []User{{ID: 1}, {ID: 1}}},
+		"product without owner":  {Products: []Product{{ID: 1}}},
+		"unknown product status": {Products: []Product{{ID: 1, OwnerID: 2, Status: "banned"}}},
+		"duplicate product":      {Products: []Product{{ID: 1, OwnerID: 2}, {ID: 1, OwnerID: 3}}},
+	}
+	for name, fixture := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(fixture, "")
+			require.Error(t, err, "Invalid fixture is accepted")
+		})
+	}
+}
+
+func TestSaveRoundTrip(t *testing.T) {
+	for _, name := range []string{"catalog.yaml", "catalog.json"} {
+		path := filepath.Join(t.TempDir(), name)
+		fixture := Default()
+		require.NoError(t, Save(path, fixture), "Save failed")
+		loaded, err := Load(path)
+		require.NoError(t, err, "Load failed")
+		require.Equal(t, fixture, loaded, "Fixture of %s mismatch", name)
+	}
+}
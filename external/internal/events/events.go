@@ -0,0 +1,122 @@
+package events
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+)
+
+// Product event types
+const (
+	ProductDeleted      = "product.deleted"
+	ProductRestored     = "product.restored"
+	ProductOwnerChanged = "product.owner_changed"
+)
+
+// User event types
+const (
+	UserBanned   = "user.banned"
+	UserUnbanned = "user.unbanned"
+)
+
+type ProductEvent struct {
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	ProductID  int64     `json:"product_id"`
+	OldOwnerID int64     `json:"old_owner_id,omitempty"`
+	NewOwnerID int64     `json:"new_owner_id,omitempty"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewProductEvent(eventType string, productID int64) ProductEvent {
+	return ProductEvent{
+		EventID:    newEventID(),
+		EventType:  eventType,
+		ProductID:  productID,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func NewOwnerChangedEvent(productID int64, oldOwnerID int64, newOwnerID int64) ProductEvent {
+	event := NewProductEvent(ProductOwnerChanged, productID)
+	event.OldOwnerID = oldOwnerID
+	event.NewOwnerID = newOwnerID
+	return event
+}
+
+type UserEvent struct {
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	UserID     int64     `json:"user_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func NewUserEvent(eventType string, userID int64) UserEvent {
+	return UserEvent{
+		EventID:    newEventID(),
+		EventType:  eventType,
+		UserID:     userID,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// Publisher emits catalog events keyed by entity id, so the events of one entity stay ordered.
+type Publisher interface {
+	Publish(topic string, key int64, event any) error
+}
+
+// KafkaPublisher sends events with a synchronous producer.
+type KafkaPublisher struct {
+	prod sarama.SyncProducer
+}
+
+func NewKafkaPublisher(brokers []string) (*KafkaPublisher, error) {
+	config := sarama.NewConfig()
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	config.Producer.Idempotent = true
+	config.Producer.Retry.Max = 10
+	config.Net.MaxOpenRequests = 1
+	config.Producer.Return.Successes = true
+	prod, err := sarama.NewSyncProducer(brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("NewSyncProducer failed: %w", err)
+	}
+	return &KafkaPublisher{prod: prod}, nil
+}
+
+func (p *KafkaPublisher) Publish(topic string, key int64, event any) error {
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	_, _, err = p.prod.SendMessage(&sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(strconv.FormatInt(key, 10)),
+		Value: sarama.ByteEncoder(bytes),
+	})
+	return err
+}
+
+func (p *KafkaPublisher) Close() error {
+	return p.prod.Close()
+}
+
+// LogPublisher only logs events, it is used when no brokers are configured.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(topic string, key int64, event any) error {
+	log.Printf("event to %s, key %d: %+v", topic, key, event)
+	return nil
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,248 @@
+package faults
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+)
+
+// Latency distributions
+const (
+	LatencyFixed       = "fixed"
+	LatencyUniform     = "uniform"
+	LatencyNormal      = "normal"
+	LatencyExponential = "exponential"
+)
+
+// maxHang bounds an injected timeout of a request without a deadline.
+const maxHang = time.Minute
+
+// adminService is never faulted, so the faults can always be changed.
+const adminService = "Admin"
+
+// Rule describes the faults of the methods it matches. Method is "*", a service name like
+// "Users", a method like "Users/CheckUserID" or a full gRPC method name.
+type Rule struct {
+	Method              string `json:"method" yaml:"method"`
+	LatencyDistribution string `json:"latency_distribution" yaml:"latency_distribution"`
+	// LatencyMs is the fixed latency, the minimum of uniform or the mean of normal and exponential.
+	LatencyMs int64 `json:"latency_ms" yaml:"latency_ms"`
+	// LatencyJitterMs is the width of uniform or the standard deviation of normal.
+	LatencyJitterMs int64   `json:"latency_jitter_ms" yaml:"latency_jitter_ms"`
+	ErrorRate       float64 `json:"error_rate" yaml:"error_rate"`
+	// ErrorCode is the gRPC code name of injected errors, UNAVAILABLE by default.
+	ErrorCode string `json:"error_code" yaml:"error_code"`
+	// TimeoutRate is the share of requests that hang until the client deadline.
+	TimeoutRate float64 `json:"timeout_rate" yaml:"timeout_rate"`
+	// FlapUpMs and FlapDownMs make the method alternate between working and failing with
+	// ErrorCode, the schedule starts when the config is applied.
+	FlapUpMs   int64 `json:"flap_up_ms" yaml:"flap_up_ms"`
+	FlapDownMs int64 `json:"flap_down_ms" yaml:"flap_down_ms"`
+
+	code codes.Code
+}
+
+// Config is the fault injection config, the same seed reproduces the same faults for the same
+// sequence of requests. A zero seed is replaced by a random one.
+type Config struct {
+	Seed  int64  `json:"seed" yaml:"seed"`
+	Rules []Rule `json:"rules" yaml:"rules"`
+}
+
+// Load reads the config file in YAML or JSON, the format is chosen by the file extension.
+func Load(path string) (Config, error) {
+	conf := Config{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return conf, fmt.Errorf("read faults config failed: %w", err)
+	}
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &conf)
+	} else {
+		err = yaml.Unmarshal(data, &conf)
+	}
+	if err != nil {
+		return conf, fmt.Errorf("parse faults config %s failed: %w", path, err)
+	}
+	return conf, nil
+}
+
+// Injector injects the configured faults into the unary calls.
+type Injector struct {
+	mu      sync.Mutex
+	conf    Config
+	rnd     *rand.Rand
+	started time.Time
+}
+
+func NewInjector() *Injector {
+	return &Injector{
+		rnd:     rand.New(rand.NewSource(1)),
+		started: time.Now(),
+	}
+}
+
+// Set validates and applies the config, the random sequence and the flapping schedules restart.
+func (i *Injector) Set(conf Config) (Config, error) {
+	rules := make([]Rule, 0, len(conf.Rules))
+	for _, rule := range conf.Rules {
+		if err := rule.validate(); err != nil {
+			return Config{}, err
+		}
+		rules = append(rules, rule)
+	}
+	conf.Rules = rules
+	if conf.Seed == 0 {
+		conf.Seed = time.Now().UnixNano()
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.conf = conf
+	i.rnd = rand.New(rand.NewSource(conf.Seed))
+	i.started = time.Now()
+	log.Printf("faults applied: seed %d, rules %d", conf.Seed, len(conf.Rules))
+	return conf, nil
+}
+
+func (i *Injector) Config() Config {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.conf
+}
+
+// Interceptor delays or fails the call according to the first matching rule.
+func (i *Injector) Interceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	f := i.roll(info.FullMethod)
+	if f.delay > 0 {
+		timer := time.NewTimer(f.delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+	if f.hang {
+		hangCtx, cancel := context.WithTimeout(ctx, maxHang)
+		defer cancel()
+		<-hangCtx.Done()
+		return nil, status.Error(codes.DeadlineExceeded, "injected timeout")
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return handler(ctx, req)
+}
+
+type fault struct {
+	delay time.Duration
+	hang  bool
+	err   error
+}
+
+func (i *Injector) roll(fullMethod string) fault {
+	service, method := splitMethod(fullMethod)
+	if service == adminService {
+		return fault{}
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	for _, rule := range i.conf.Rules {
+		if !rule.matches(fullMethod, service, method) {
+			continue
+		}
+		f := fault{delay: rule.latency(i.rnd)}
+		switch {
+		case rule.down(time.Since(i.started)):
+			f.err = status.Error(rule.code, "injected outage")
+		case rule.TimeoutRate > 0 && i.rnd.Float64() < rule.TimeoutRate:
+			f.hang = true
+		case rule.ErrorRate > 0 && i.rnd.Float64() < rule.ErrorRate:
+			f.err = status.Error(rule.code, "injected error")
+		}
+		return f
+	}
+	return fault{}
+}
+
+func (r *Rule) validate() error {
+	if r.Method == "" {
+		return fmt.Errorf("rule without method")
+	}
+	if r.ErrorRate < 0 || r.ErrorRate > 1 || r.TimeoutRate < 0 || r.TimeoutRate > 1 {
+		return fmt.Errorf("rule %s: rates must be in [0, 1]", r.Method)
+	}
+	if r.LatencyMs < 0 || r.LatencyJitterMs < 0 || r.FlapUpMs < 0 || r.FlapDownMs < 0 {
+		return fmt.Errorf("rule %s: durations must not be negative", r.Method)
+	}
+	switch r.LatencyDistribution {
+	case "":
+		r.LatencyDistribution = LatencyFixed
+	case LatencyFixed, LatencyUniform, LatencyNormal, LatencyExponential:
+	default:
+		return fmt.Errorf("rule %s: unknown latency distribution %q", r.Method, r.LatencyDistribution)
+	}
+	r.ErrorCode = strings.ToUpper(r.ErrorCode)
+	if r.ErrorCode == "" {
+		r.ErrorCode = "UNAVAILABLE"
+	}
+	if err := r.code.UnmarshalJSON([]byte(strconv.Quote(r.ErrorCode))); err != nil {
+		return fmt.Errorf("rule %s: %w", r.Method, err)
+	}
+	if r.code == codes.OK {
+		return fmt.Errorf("rule %s: error code must not be OK", r.Method)
+	}
+	return nil
+}
+
+func (r *Rule) matches(fullMethod string, service string, method string) bool {
+	switch r.Method {
+	case "*", fullMethod, service, service + "/" + method:
+		return true
+	}
+	return false
+}
+
+func (r *Rule) latency(rnd *rand.Rand) time.Duration {
+	ms := float64(r.LatencyMs)
+	switch r.LatencyDistribution {
+	case LatencyUniform:
+		ms += rnd.Float64() * float64(r.LatencyJitterMs)
+	case LatencyNormal:
+		ms = math.Max(0, ms+rnd.NormFloat64()*float64(r.LatencyJitterMs))
+	case LatencyExponential:
+		ms = rnd.ExpFloat64() * ms
+	}
+	return time.Duration(ms * float64(time.Millisecond))
+}
+
+func (r *Rule) down(elapsed time.Duration) bool {
+	if r.FlapDownMs == 0 {
+		return false
+	}
+	period := time.Duration(r.FlapUpMs+r.FlapDownMs) * time.Millisecond
+	return elapsed%period >= time.Duration(r.FlapUpMs)*time.Millisecond
+}
+
+// splitMethod turns "/example.pkg.api.external.v1.Users/CheckUserID" into "Users" and "CheckUserID".
+func splitMethod(fullMethod string) (string, string) {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	if dot := strings.LastIndex(service, "."); dot >= 0 {
+		service = service[dot+1:]
+	}
+	return service, method
+}
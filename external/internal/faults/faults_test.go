@@ -0,0 +1,120 @@
+package faults
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const checkUserID = "/example.pkg.api.external.v1.Users/CheckUserID"
+
+func sequence(t *testing.T, conf Config, n int) []fault {
+	injector := NewInjector()
+	_, err := injector.Set(conf)
+	require.NoError(t, err, "Set failed")
+	res := make([]fault, n)
+	for i := range res {
+		res[i] = injector.roll(checkUserID)
+	}
+	return res
+}
+
+func TestFixedSeedIsDeterministic(t *testing.T) {
+	conf := Config{
+		Seed: 42,
+		Rules: []Rule{{
+			Method:              "Users",
+			LatencyDistribution: LatencyNormal,
+			LatencyMs:           150,
+			LatencyJitterMs:     50,
+			ErrorRate:           0.3,
+			TimeoutRate:         0.1,
+		}},
+	}
+	first := sequence(t, conf, 200)
+	require.Equal(t, first, sequence(t, conf, 200), "Faults of one seed mismatch")
+
+	conf.Seed = 43
+	require.NotEqual(t, first, sequence(t, conf, 200), "Faults of different seeds match")
+
+	errs, hangs := 0, 0
+	for _, f := range first {
+		if f.err != nil {
+			require.Equal(t, codes.Unavailable, status.Code(f.err), "Default error code mismatch")
+			errs++
+		}
+		if f.hang {
+			hangs++
+		}
+	}
+	require.Positive(t, errs, "No injected errors")
+	require.Positive(t, hangs, "No injected timeouts")
+}
+
+func TestSetRestartsSequence(t *testing.T) {
+	conf := Config{Seed: 7, Rules: []Rule{{Method: "*", LatencyDistribution: LatencyUniform, LatencyMs: 10, LatencyJitterMs: 100}}}
+	injector := NewInjector()
+	_, err := injector.Set(conf)
+	require.NoError(t, err, "Set failed")
+	first := []fault{injector.roll(checkUserID), injector.roll(checkUserID)}
+	_, err = injector.Set(conf)
+	require.NoError(t, err, "Set failed")
+	require.Equal(t, first, []fault{injector.roll(checkUserID), injector.roll(checkUserID)}, "Sequence is not restarted")
+}
+
+func TestAdminIsNotFaulted(t *testing.T) {
+	injector := NewInjector()
+	_, err := injector.Set(Config{Seed: 1, Rules: []Rule{{Method: "*", ErrorRate: 1}}})
+	require.NoError(t, err, "Set failed")
+	require.Equal(t, fault{}, injector.roll("/example.pkg.api.external.v1.Admin/BanUser"), "Admin is faulted")
+	require.Error(t, injector.roll(checkUserID).err, "Users is not faulted")
+}
+
+func TestRuleMatching(t *testing.T) {
+	injector := NewInjector()
+	_, err := injector.Set(Config{Seed: 1, Rules: []Rule{
+		{Method: "Users/GetUsers", ErrorRate: 1, ErrorCode: "internal"},
+		{Method: "Products", ErrorRate: 1, ErrorCode: "RESOURCE_EXHAUSTED"},
+	}})
+	require.NoError(t, err, "Set failed")
+	require.Equal(t, codes.Internal, status.Code(injector.roll("/example.pkg.api.external.v1.Users/GetUsers").err), "Method rule mismatch")
+	require.Equal(t, codes.ResourceExhausted, status.Code(injector.roll("/example.pkg.api.external.v1.Products/GetOwner").err), "Service rule mismatch")
+	require.Equal(t, fault{}, injector.roll(checkUserID), "Unmatched method is faulted")
+}
+
+func TestFlapping(t *testing.T) {
+	rule := Rule{FlapUpMs: 100, FlapDownMs: 50}
+	require.False(t, rule.down(50*time.Millisecond), "Down while up")
+	require.True(t, rule.down(120*time.Millisecond), "Up while down")
+	require.False(t, rule.down(160*time.Millisecond), "Down in the next period")
+}
+
+func TestSetRejectsInvalidRules(t *testing.T) {
+	tests := map[string]Rule{
+		"without method":       {},
+		"rate above one":       {Method: "*", ErrorRate: 1.5},
+		"negative latency":     {Method: "*", LatencyMs: -1},
+		"unknown distribution": {Method: "*", LatencyDistribution: "pareto"},
+		"unknown code":         {Method: "*", ErrorCode: "BROKEN"},
+		"ok code":              {Method: "*", ErrorCode: "OK"},
+	}
+	for name, rule := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewInjector().Set(Config{Rules: []Rule{rule}})
+			require.Error(t, err, "Invalid rule is accepted")
+		})
+	}
+}
+
+func TestLoadExampleConfig(t *testing.T) {
+	conf, err := Load(filepath.Join("..", "..", "fixtures", "faults.yaml"))
+	require.NoError(t, err, "Load failed")
+	require.Equal(t, int64(42), conf.Seed, "Seed mismatch")
+	applied, err := NewInjector().Set(conf)
+	require.NoError(t, err, "Example config is invalid")
+	require.Equal(t, len(conf.Rules), len(applied.Rules), "Rules mismatch")
+}
@@ -2,27 +2,351 @@ package server
 
 import (
 	"context"
+	"errors"
+	"example/external/internal/catalog"
+	"example/external/internal/events"
+	"example/external/internal/faults"
 	servicepb "example/external/pkg/api/v1"
+	"log"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
 )
 
 type Controller struct {
 	servicepb.UnimplementedUsersServer
 	servicepb.UnimplementedProductsServer
+	servicepb.UnimplementedAdminServer
+	catalog       *catalog.Catalog
+	publisher     events.Publisher
+	faults        *faults.Injector
+	health        *health.Server
+	productsTopic string
+	usersTopic    string
+}
+
+func NewController(catalog *catalog.Catalog, publisher events.Publisher, faults *faults.Injector,
+	healthServer *health.Server, productsTopic string, usersTopic string) *Controller {
+	return &Controller{
+		catalog:       catalog,
+		publisher:     publisher,
+		faults:        faults,
+		health:        healthServer,
+		productsTopic: productsTopic,
+		usersTopic:    usersTopic,
+	}
 }
 
 func (s *Controller) CheckUserID(_ context.Context, in *servicepb.CheckUserIDRequest) (*servicepb.CheckUserIDResponse, error) {
 	return &servicepb.CheckUserIDResponse{
-		IsCorrect: in.UserID < 100,
+		IsCorrect: s.catalog.IsUser(in.UserID),
 	}, nil
 }
 
-func (s *Controller) GetOwner(_ context.Context, in *servicepb.GetOwnerRequest) (*servicepb.GetOwnerResponse, error) {
-	if in.ProductID > 100 {
-		return &servicepb.GetOwnerResponse{
-			OwnerID: 0,
-		}, nil
+func (s *Controller) CheckUserIDs(_ context.Context, in *servicepb.CheckUserIDsRequest) (*servicepb.CheckUserIDsResponse, error) {
+	res := make(map[int64]bool, len(in.UserIDs))
+	for _, userID := range in.UserIDs {
+		res[userID] = s.catalog.IsUser(userID)
+	}
+	return &servicepb.CheckUserIDsResponse{
+		IsCorrect: res,
+	}, nil
+}
+
+func (s *Controller) GetUserProfiles(_ context.Context, in *servicepb.GetUserProfilesRequest) (*servicepb.GetUserProfilesResponse, error) {
+	users := s.catalog.Users(in.UserIDs)
+	res := make(map[int64]*servicepb.UserProfile, len(users))
+	for _, user := range users {
+		res[user.ID] = &servicepb.UserProfile{
+			UserID:      user.ID,
+			DisplayName: user.Name,
+			AvatarURL:   user.AvatarURL,
+		}
 	}
+	return &servicepb.GetUserProfilesResponse{
+		Profiles: res,
+	}, nil
+}
+
+func (s *Controller) GetOwner(_ context.Context, in *servicepb.GetOwnerRequest) (*servicepb.GetOwnerResponse, error) {
 	return &servicepb.GetOwnerResponse{
-		OwnerID: in.ProductID + 73,
+		OwnerID: s.catalog.Owner(in.ProductID),
+	}, nil
+}
+
+func (s *Controller) GetOwners(_ context.Context, in *servicepb.GetOwnersRequest) (*servicepb.GetOwnersResponse, error) {
+	res := make(map[int64]int64, len(in.ProductIDs))
+	for _, productID := range in.ProductIDs {
+		res[productID] = s.catalog.Owner(productID)
+	}
+	return &servicepb.GetOwnersResponse{
+		Owners: res,
+	}, nil
+}
+
+func (s *Controller) DeleteProduct(_ context.Context, in *servicepb.DeleteProductRequest) (*servicepb.DeleteProductResponse, error) {
+	changed, err := s.setProductDeleted(in.ProductID, true)
+	if err != nil {
+		return nil, err
+	}
+	return &servicepb.DeleteProductResponse{Changed: changed}, nil
+}
+
+func (s *Controller) RestoreProduct(_ context.Context, in *servicepb.RestoreProductRequest) (*servicepb.RestoreProductResponse, error) {
+	changed, err := s.setProductDeleted(in.ProductID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &servicepb.RestoreProductResponse{Changed: changed}, nil
+}
+
+// setProductDeleted changes the product state and emits the event, the change is reverted if
+// the event is not published, so the request can be retried.
+func (s *Controller) setProductDeleted(productID int64, deleted bool) (bool, error) {
+	if !s.catalog.SetDeleted(productID, deleted) {
+		return false, nil
+	}
+	eventType := events.ProductRestored
+	if deleted {
+		eventType = events.ProductDeleted
+	}
+	err := s.publisher.Publish(s.productsTopic, productID, events.NewProductEvent(eventType, productID))
+	if err != nil {
+		s.catalog.SetDeleted(productID, !deleted)
+		log.Printf("publish %s failed: %v", eventType, err)
+		return false, status.Error(codes.Unavailable, "Event publishing failed")
+	}
+	return true, nil
+}
+
+// ChangeProductOwner hands the product over to the new owner and emits the event, the change
+// is reverted if the event is not published, so the request can be retried.
+func (s *Controller) ChangeProductOwner(_ context.Context, in *servicepb.ChangeProductOwnerRequest) (*servicepb.ChangeProductOwnerResponse, error) {
+	prev, changed := s.catalog.SetOwner(in.ProductID, in.OwnerID)
+	if prev == 0 {
+		return nil, status.Error(codes.NotFound, "Product not found")
+	}
+	if changed {
+		event := events.NewOwnerChangedEvent(in.ProductID, prev, in.OwnerID)
+		if err := s.publisher.Publish(s.productsTopic, in.ProductID, event); err != nil {
+			s.catalog.SetOwner(in.ProductID, prev)
+			log.Printf("publish %s failed: %v", events.ProductOwnerChanged, err)
+			return nil, status.Error(codes.Unavailable, "Event publishing failed")
+		}
+	}
+	return &servicepb.ChangeProductOwnerResponse{
+		Changed:         changed,
+		PreviousOwnerID: prev,
+	}, nil
+}
+
+func (s *Controller) BanUser(_ context.Context, in *servicepb.BanUserRequest) (*servicepb.BanUserResponse, error) {
+	changed, err := s.setUserBanned(in.UserID, true)
+	if err != nil {
+		return nil, err
+	}
+	return &servicepb.BanUserResponse{Changed: changed}, nil
+}
+
+func (s *Controller) UnbanUser(_ context.Context, in *servicepb.UnbanUserRequest) (*servicepb.UnbanUserResponse, error) {
+	changed, err := s.setUserBanned(in.UserID, false)
+	if err != nil {
+		return nil, err
+	}
+	return &servicepb.UnbanUserResponse{Changed: changed}, nil
+}
+
+// setUserBanned changes the user state and emits the event, the change is reverted if the
+// event is not published, so the request can be retried.
+func (s *Controller) setUserBanned(userID int64, banned bool) (bool, error) {
+	if !s.catalog.SetBanned(userID, banned) {
+		return false, nil
+	}
+	eventType := events.UserUnbanned
+	if banned {
+		eventType = events.UserBanned
+	}
+	err := s.publisher.Publish(s.usersTopic, userID, events.NewUserEvent(eventType, userID))
+	if err != nil {
+		s.catalog.SetBanned(userID, !banned)
+		log.Printf("publish %s failed: %v", eventType, err)
+		return false, status.Error(codes.Unavailable, "Event publishing failed")
+	}
+	return true, nil
+}
+
+func (s *Controller) CreateUser(_ context.Context, in *servicepb.CreateUserRequest) (*servicepb.CreateUserResponse, error) {
+	user, err := s.catalog.AddUser(catalog.User{ID: in.UserID, Name: in.Name, AvatarURL: in.AvatarURL})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	return &servicepb.CreateUserResponse{User: toUser(user)}, nil
+}
+
+func (s *Controller) UpdateUser(_ context.Context, in *servicepb.UpdateUserRequest) (*servicepb.UpdateUserResponse, error) {
+	user, err := s.catalog.UpdateUser(in.UserID, in.Name, in.AvatarURL)
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	return &servicepb.UpdateUserResponse{User: toUser(user)}, nil
+}
+
+// RemoveUser removes the user, an active user is reported as banned so the comments service
+// hides the comments. The user is restored if the event is not published.
+func (s *Controller) RemoveUser(_ context.Context, in *servicepb.RemoveUserRequest) (*servicepb.RemoveUserResponse, error) {
+	user, err := s.catalog.RemoveUser(in.UserID)
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	if user.Status == catalog.UserActive {
+		err = s.publisher.Publish(s.usersTopic, user.ID, events.NewUserEvent(events.UserBanned, user.ID))
+		if err != nil {
+			_, _ = s.catalog.AddUser(user)
+			log.Printf("publish %s failed: %v", events.UserBanned, err)
+			return nil, status.Error(codes.Unavailable, "Event publishing failed")
+		}
+	}
+	return &servicepb.RemoveUserResponse{User: toUser(user)}, nil
+}
+
+func (s *Controller) CreateProduct(_ context.Context, in *servicepb.CreateProductRequest) (*servicepb.CreateProductResponse, error) {
+	product, err := s.catalog.AddProduct(catalog.Product{ID: in.ProductID, Name: in.Name, OwnerID: in.OwnerID})
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	return &servicepb.CreateProductResponse{Product: toProduct(product)}, nil
+}
+
+func (s *Controller) UpdateProduct(_ context.Context, in *servicepb.UpdateProductRequest) (*servicepb.UpdateProductResponse, error) {
+	product, err := s.catalog.RenameProduct(in.ProductID, in.Name)
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	return &servicepb.UpdateProductResponse{Product: toProduct(product)}, nil
+}
+
+// RemoveProduct removes the product, an active product is reported as deleted so the comments
+// service hides the comments. The product is restored if the event is not published.
+func (s *Controller) RemoveProduct(_ context.Context, in *servicepb.RemoveProductRequest) (*servicepb.RemoveProductResponse, error) {
+	product, err := s.catalog.RemoveProduct(in.ProductID)
+	if err != nil {
+		return nil, catalogError(err)
+	}
+	if product.Status == catalog.ProductActive {
+		err = s.publisher.Publish(s.productsTopic, product.ID, events.NewProductEvent(events.ProductDeleted, product.ID))
+		if err != nil {
+			_, _ = s.catalog.AddProduct(product)
+			log.Printf("publish %s failed: %v", events.ProductDeleted, err)
+			return nil, status.Error(codes.Unavailable, "Event publishing failed")
+		}
+	}
+	return &servicepb.RemoveProductResponse{Product: toProduct(product)}, nil
+}
+
+func (s *Controller) SetFaults(_ context.Context, in *servicepb.SetFaultsRequest) (*servicepb.SetFaultsResponse, error) {
+	conf := faults.Config{
+		Seed:  in.Seed,
+		Rules: make([]faults.Rule, 0, len(in.Rules)),
+	}
+	for _, rule := range in.Rules {
+		conf.Rules = append(conf.Rules, faults.Rule{
+			Method:              rule.Method,
+			LatencyDistribution: rule.LatencyDistribution,
+			LatencyMs:           rule.LatencyMs,
+			LatencyJitterMs:     rule.LatencyJitterMs,
+			ErrorRate:           rule.ErrorRate,
+			ErrorCode:           rule.ErrorCode,
+			TimeoutRate:         rule.TimeoutRate,
+			FlapUpMs:            rule.FlapUpMs,
+			FlapDownMs:          rule.FlapDownMs,
+		})
+	}
+	conf, err := s.faults.Set(conf)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &servicepb.SetFaultsResponse{
+		Seed:  conf.Seed,
+		Rules: toFaultRules(conf.Rules),
+	}, nil
+}
+
+func (s *Controller) GetFaults(_ context.Context, _ *servicepb.GetFaultsRequest) (*servicepb.GetFaultsResponse, error) {
+	conf := s.faults.Config()
+	return &servicepb.GetFaultsResponse{
+		Seed:  conf.Seed,
+		Rules: toFaultRules(conf.Rules),
+	}, nil
+}
+
+// SetServing changes the health of the Users and Products services, Admin stays available to
+// switch them back.
+func (s *Controller) SetServing(_ context.Context, in *servicepb.SetServingRequest) (*servicepb.SetServingResponse, error) {
+	SetServing(s.health, in.Serving)
+	log.Printf("serving set to %t", in.Serving)
+	return &servicepb.SetServingResponse{
+		Serving: in.Serving,
 	}, nil
 }
+
+// SetServing sets the health status of the whole server and of the Users and Products services.
+func SetServing(healthServer *health.Server, serving bool) {
+	servingStatus := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		servingStatus = healthpb.HealthCheckResponse_SERVING
+	}
+	for _, service := range []string{"", servicepb.Users_ServiceDesc.ServiceName, servicepb.Products_ServiceDesc.ServiceName} {
+		healthServer.SetServingStatus(service, servingStatus)
+	}
+}
+
+func catalogError(err error) error {
+	switch {
+	case errors.Is(err, catalog.ErrUserNotFound):
+		return status.Error(codes.NotFound, "User not found")
+	case errors.Is(err, catalog.ErrProductNotFound):
+		return status.Error(codes.NotFound, "Product not found")
+	case errors.Is(err, catalog.ErrAlreadyExists):
+		return status.Error(codes.AlreadyExists, "Already exists")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toUser(user catalog.User) *servicepb.User {
+	return &servicepb.User{
+		UserID:    user.ID,
+		Name:      user.Name,
+		Status:    user.Status,
+		AvatarURL: user.AvatarURL,
+	}
+}
+
+func toProduct(product catalog.Product) *servicepb.Product {
+	return &servicepb.Product{
+		ProductID: product.ID,
+		Name:      product.Name,
+		OwnerID:   product.OwnerID,
+		Status:    product.Status,
+	}
+}
+
+func toFaultRules(rules []faults.Rule) []*servicepb.FaultRule {
+	res := make([]*servicepb.FaultRule, 0, len(rules))
+	for _, rule := range rules {
+		res = append(res, &servicepb.FaultRule{
+			Method:              rule.Method,
+			LatencyDistribution: rule.LatencyDistribution,
+			LatencyMs:           rule.LatencyMs,
+			LatencyJitterMs:     rule.LatencyJitterMs,
+			ErrorRate:           rule.ErrorRate,
+			ErrorCode:           rule.ErrorCode,
+			TimeoutRate:         rule.TimeoutRate,
+			FlapUpMs:            rule.FlapUpMs,
+			FlapDownMs:          rule.FlapDownMs,
+		})
+	}
+	return res
+}